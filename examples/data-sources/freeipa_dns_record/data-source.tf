@@ -0,0 +1,8 @@
+data "freeipa_dns_record" "kerberos" {
+  zone = "example.com"
+  name = "_kerberos._udp"
+}
+
+output "kdc_srv_records" {
+  value = data.freeipa_dns_record.kerberos.srv_records
+}
@@ -0,0 +1,10 @@
+data "freeipa_dns_records" "zone" {
+  zone = "example.com"
+}
+
+output "address_records" {
+  value = {
+    for r in data.freeipa_dns_records.zone.records : r.name => r.a_records
+    if r.a_records != null
+  }
+}
@@ -0,0 +1,23 @@
+variable "lab_ds_records" {
+  description = "DS records of lab.example.com, such as 12345 13 2 0123456789abcdef..."
+  type        = set(string)
+}
+
+resource "freeipa_dns_zone" "child" {
+  name       = "lab.example.com"
+  dnssec     = true
+  nsec3param = "1 0 10 BEEF"
+}
+
+data "freeipa_dns_zone" "child" {
+  name = freeipa_dns_zone.child.name
+}
+
+# Delegate the child zone from its parent, the DS records are computed from
+# the DNSKEY records served by the IPA DNS servers.
+resource "freeipa_dns_record" "delegation" {
+  zone       = "example.com"
+  name       = "lab"
+  ns_records = data.freeipa_dns_zone.child.name_servers
+  ds_records = var.lab_ds_records
+}
@@ -0,0 +1,12 @@
+data "freeipa_hbac_test" "admin_ssh" {
+  user       = "admin"
+  targethost = "web01.corp.example.com"
+  service    = "sshd"
+}
+
+check "admin_keeps_ssh_access" {
+  assert {
+    condition     = data.freeipa_hbac_test.admin_ssh.access_granted
+    error_message = "admin would lose SSH access to web01"
+  }
+}
@@ -0,0 +1,5 @@
+resource "freeipa_dns_record" "ptr" {
+  zone        = freeipa_dns_zone.reverse.name
+  name        = provider::freeipa::reverse_record_name("10.1.5.7", freeipa_dns_zone.reverse.name)
+  ptr_records = ["web01.example.com."]
+}
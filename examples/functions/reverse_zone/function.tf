@@ -0,0 +1,3 @@
+resource "freeipa_dns_zone" "reverse" {
+  name = provider::freeipa::reverse_zone("10.1.4.0/22")
+}
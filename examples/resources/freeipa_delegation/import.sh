@@ -0,0 +1,2 @@
+# Delegation rules can be imported by name
+terraform import freeipa_delegation.managers_phone_numbers "Managers edit the phone numbers of their team"
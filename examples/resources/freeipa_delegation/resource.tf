@@ -0,0 +1,7 @@
+resource "freeipa_delegation" "managers_phone_numbers" {
+  name        = "Managers edit the phone numbers of their team"
+  permissions = ["write"]
+  attributes  = ["telephonenumber", "mobile"]
+  group       = freeipa_group.managers.name
+  membergroup = freeipa_group.team.name
+}
@@ -0,0 +1,2 @@
+# The DNS configuration can be imported with the dnsconfig identifier
+terraform import freeipa_dns_config.config dnsconfig
@@ -0,0 +1,5 @@
+resource "freeipa_dns_config" "config" {
+  forwarders     = ["192.0.2.53", "192.0.2.54"]
+  forward_policy = "only"
+  allow_sync_ptr = true
+}
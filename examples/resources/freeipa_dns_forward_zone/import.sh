@@ -0,0 +1,2 @@
+# DNS forward zones can be imported by name
+terraform import freeipa_dns_forward_zone.ad ad.example.com
@@ -0,0 +1,5 @@
+resource "freeipa_dns_forward_zone" "ad" {
+  name           = "ad.example.com"
+  forwarders     = ["192.0.2.53", "192.0.2.54"]
+  forward_policy = "only"
+}
@@ -0,0 +1,3 @@
+# DNS records can be imported by zone and name. All the record types of the
+# name are then managed.
+terraform import freeipa_dns_record.www example.com/www
@@ -0,0 +1,21 @@
+resource "freeipa_dns_record" "www" {
+  zone         = freeipa_dns_zone.example.name
+  name         = "www"
+  ttl          = 300
+  a_records    = ["192.0.2.10", "192.0.2.11"]
+  aaaa_records = ["2001:db8::10"]
+}
+
+resource "freeipa_dns_record" "apex" {
+  zone        = freeipa_dns_zone.example.name
+  name        = "@"
+  mx_records  = ["10 mail.example.com."]
+  txt_records = ["v=spf1 mx -all"]
+  caa_records = ["0 issue \"letsencrypt.org\""]
+}
+
+resource "freeipa_dns_record" "ldap" {
+  zone        = freeipa_dns_zone.example.name
+  name        = "_ldap._tcp"
+  srv_records = ["0 100 389 ldap1.example.com.", "0 100 389 ldap2.example.com."]
+}
@@ -0,0 +1,2 @@
+# DNS servers can be imported by name
+terraform import freeipa_dns_server.ipa01 ipa01.example.com
@@ -0,0 +1,6 @@
+resource "freeipa_dns_server" "ipa01" {
+  name           = "ipa01.example.com"
+  soa_mname      = "ns1.example.com."
+  forwarders     = ["192.0.2.53"]
+  forward_policy = "first"
+}
@@ -0,0 +1,2 @@
+# DNS zones can be imported by name
+terraform import freeipa_dns_zone.example example.com
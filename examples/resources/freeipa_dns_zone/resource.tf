@@ -0,0 +1,14 @@
+resource "freeipa_dns_zone" "example" {
+  name           = "example.com"
+  soa_rname      = "dnsadmin.example.com."
+  soa_refresh    = 1800
+  ttl            = 3600
+  dynamic_update = true
+  allow_transfer = "192.0.2.10;192.0.2.11;"
+  allow_sync_ptr = true
+}
+
+resource "freeipa_dns_zone" "reverse" {
+  name           = "2.0.192.in-addr.arpa"
+  dynamic_update = true
+}
@@ -0,0 +1,2 @@
+# DNS zone permissions can be imported by zone name
+terraform import freeipa_dns_zone_permission.lab lab.example.com
@@ -0,0 +1,8 @@
+resource "freeipa_dns_zone_permission" "lab" {
+  zone = freeipa_dns_zone.lab.name
+}
+
+resource "freeipa_privilege" "lab_dns" {
+  name        = "Lab DNS administrators"
+  permissions = [freeipa_dns_zone_permission.lab.permission_name]
+}
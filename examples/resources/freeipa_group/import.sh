@@ -0,0 +1,2 @@
+# Groups can be imported by name
+terraform import freeipa_group.developers developers
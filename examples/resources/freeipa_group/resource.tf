@@ -0,0 +1,10 @@
+resource "freeipa_group" "developers" {
+  name        = "developers"
+  description = "Developers"
+}
+
+resource "freeipa_group" "ad_admins" {
+  name        = "ad_admins_external"
+  description = "Active Directory administrators"
+  external    = true
+}
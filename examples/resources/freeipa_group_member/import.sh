@@ -0,0 +1,2 @@
+# Group members can be imported using the group/type/name format
+terraform import freeipa_group_member.jdoe_admins admins/user/jdoe
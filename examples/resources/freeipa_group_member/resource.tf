@@ -0,0 +1,5 @@
+resource "freeipa_group_member" "jdoe_admins" {
+  group = "admins"
+  type  = "user"
+  name  = freeipa_user.jdoe.login
+}
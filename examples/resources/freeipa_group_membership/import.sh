@@ -0,0 +1,2 @@
+# Group memberships can be imported by group name
+terraform import freeipa_group_membership.developers developers
@@ -0,0 +1,5 @@
+resource "freeipa_group_membership" "developers" {
+  group  = freeipa_group.developers.name
+  users  = ["jdoe", "asmith"]
+  groups = [freeipa_group.contractors.name]
+}
@@ -0,0 +1,2 @@
+# HBAC rules can be imported by name
+terraform import freeipa_hbac_rule.admins_ssh admins_ssh
@@ -0,0 +1,14 @@
+resource "freeipa_hbac_rule" "admins_ssh" {
+  name        = "admins_ssh"
+  description = "Allow admins to log in to the web servers over SSH"
+  groups      = [freeipa_group.admins.name]
+  hostgroups  = [freeipa_hostgroup.webservers.name]
+  services    = ["sshd"]
+}
+
+resource "freeipa_hbac_rule" "helpdesk_everywhere" {
+  name            = "helpdesk_everywhere"
+  groups          = [freeipa_group.helpdesk.name]
+  hostcategory    = "all"
+  servicecategory = "all"
+}
@@ -0,0 +1,2 @@
+# HBAC services can be imported by name
+terraform import freeipa_hbac_service.cockpit cockpit
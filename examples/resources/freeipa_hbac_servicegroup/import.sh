@@ -0,0 +1,2 @@
+# HBAC service groups can be imported by name
+terraform import freeipa_hbac_servicegroup.remote_access remote_access
@@ -0,0 +1,12 @@
+resource "freeipa_hbac_servicegroup" "remote_access" {
+  name        = "remote_access"
+  description = "Services used to log in remotely"
+  services    = ["sshd", freeipa_hbac_service.cockpit.name]
+}
+
+resource "freeipa_hbac_rule" "admins_remote_access" {
+  name           = "admins_remote_access"
+  groups         = ["admins"]
+  hostcategory   = "all"
+  service_groups = [freeipa_hbac_servicegroup.remote_access.name]
+}
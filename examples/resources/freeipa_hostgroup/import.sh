@@ -0,0 +1,2 @@
+# Hostgroups can be imported by name
+terraform import freeipa_hostgroup.webservers webservers
@@ -0,0 +1,4 @@
+resource "freeipa_hostgroup" "webservers" {
+  name        = "webservers"
+  description = "Web servers"
+}
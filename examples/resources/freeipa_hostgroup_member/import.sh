@@ -0,0 +1,2 @@
+# Hostgroup members can be imported using the hostgroup/type/name format
+terraform import freeipa_hostgroup_member.web03 webservers/host/web03.corp.example.com
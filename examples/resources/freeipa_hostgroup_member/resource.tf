@@ -0,0 +1,5 @@
+resource "freeipa_hostgroup_member" "web03" {
+  hostgroup = freeipa_hostgroup.webservers.name
+  type      = "host"
+  name      = freeipa_host.web03.fqdn
+}
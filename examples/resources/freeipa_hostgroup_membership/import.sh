@@ -0,0 +1,2 @@
+# Hostgroup memberships can be imported by hostgroup name
+terraform import freeipa_hostgroup_membership.webservers webservers
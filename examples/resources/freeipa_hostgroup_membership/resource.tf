@@ -0,0 +1,5 @@
+resource "freeipa_hostgroup_membership" "webservers" {
+  hostgroup  = freeipa_hostgroup.webservers.name
+  hosts      = ["web01.corp.example.com", "web02.corp.example.com"]
+  hostgroups = [freeipa_hostgroup.frontends.name]
+}
@@ -0,0 +1,2 @@
+# Locations can be imported by name
+terraform import freeipa_location.paris paris
@@ -0,0 +1,4 @@
+resource "freeipa_location" "paris" {
+  name        = "paris"
+  description = "Paris datacenter"
+}
@@ -0,0 +1,2 @@
+# Permissions can be imported by name
+terraform import freeipa_permission.helpdesk_phone_numbers "Helpdesk - Modify phone numbers"
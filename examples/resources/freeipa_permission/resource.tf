@@ -0,0 +1,7 @@
+resource "freeipa_permission" "helpdesk_phone_numbers" {
+  name       = "Helpdesk - Modify phone numbers"
+  rights     = ["write"]
+  type       = "user"
+  attributes = ["telephonenumber", "mobile"]
+  memberof   = ["employees"]
+}
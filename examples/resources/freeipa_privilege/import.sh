@@ -0,0 +1,2 @@
+# Privileges can be imported by name
+terraform import freeipa_privilege.helpdesk Helpdesk
@@ -0,0 +1,8 @@
+resource "freeipa_privilege" "helpdesk" {
+  name        = "Helpdesk"
+  description = "Everyday helpdesk tasks"
+  permissions = [
+    freeipa_permission.helpdesk_phone_numbers.name,
+    "System: Change User password",
+  ]
+}
@@ -0,0 +1,2 @@
+# Roles can be imported by name
+terraform import freeipa_role.helpdesk Helpdesk
@@ -0,0 +1,6 @@
+resource "freeipa_role" "helpdesk" {
+  name        = "Helpdesk"
+  description = "Helpdesk operators"
+  groups      = [freeipa_group.helpdesk.name]
+  privileges  = [freeipa_privilege.helpdesk.name]
+}
@@ -0,0 +1,2 @@
+# Self-service rules can be imported by name
+terraform import freeipa_selfservice.contact_details "Users can manage their own contact details"
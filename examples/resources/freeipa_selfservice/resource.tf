@@ -0,0 +1,4 @@
+resource "freeipa_selfservice" "contact_details" {
+  name       = "Users can manage their own contact details"
+  attributes = ["telephonenumber", "mobile", "pager"]
+}
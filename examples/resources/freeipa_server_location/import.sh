@@ -0,0 +1,2 @@
+# Server locations can be imported by server name
+terraform import freeipa_server_location.ipa01 ipa01.example.com
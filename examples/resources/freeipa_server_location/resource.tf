@@ -0,0 +1,5 @@
+resource "freeipa_server_location" "ipa01" {
+  server         = "ipa01.example.com"
+  location       = freeipa_location.paris.name
+  service_weight = 200
+}
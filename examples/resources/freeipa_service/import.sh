@@ -0,0 +1,2 @@
+# Services can be imported by principal
+terraform import freeipa_service.app HTTP/app.example.com
@@ -0,0 +1,12 @@
+resource "freeipa_service" "app" {
+  principal         = "HTTP/app.example.com"
+  auth_indicators   = ["otp", "pkinit"]
+  principal_aliases = ["HTTP/www.example.com"]
+  managed_by_hosts  = ["app.example.com", "proxy.example.com"]
+}
+
+resource "freeipa_service" "db" {
+  principal       = "postgres/db.example.com"
+  skip_host_check = true
+  pac_type        = ["MS-PAC"]
+}
@@ -0,0 +1,2 @@
+# Service delegation rules can be imported by name
+terraform import freeipa_servicedelegation_rule.app_to_db app-to-db
@@ -0,0 +1,5 @@
+resource "freeipa_servicedelegation_rule" "app_to_db" {
+  name       = "app-to-db"
+  principals = [freeipa_service.app.principal]
+  targets    = [freeipa_servicedelegation_target.db.name]
+}
@@ -0,0 +1,2 @@
+# Service delegation targets can be imported by name
+terraform import freeipa_servicedelegation_target.db db-target
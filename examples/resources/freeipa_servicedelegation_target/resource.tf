@@ -0,0 +1,4 @@
+resource "freeipa_servicedelegation_target" "db" {
+  name       = "db-target"
+  principals = [freeipa_service.db.principal]
+}
@@ -0,0 +1,2 @@
+# Sudo commands can be imported by command path
+terraform import freeipa_sudo_command.systemctl /usr/bin/systemctl
@@ -0,0 +1,4 @@
+resource "freeipa_sudo_command" "systemctl" {
+  command     = "/usr/bin/systemctl"
+  description = "Control the systemd system and service manager"
+}
@@ -0,0 +1,2 @@
+# Sudo command groups can be imported by name
+terraform import freeipa_sudo_command_group.logs logs
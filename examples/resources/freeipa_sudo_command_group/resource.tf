@@ -0,0 +1,15 @@
+resource "freeipa_sudo_command_group" "logs" {
+  name        = "logs"
+  description = "Commands to read the logs"
+  commands = [
+    freeipa_sudo_command.journalctl.command,
+    freeipa_sudo_command.less.command,
+  ]
+}
+
+resource "freeipa_sudo_rule" "helpdesk_logs" {
+  name                 = "helpdesk_logs"
+  groups               = ["helpdesk"]
+  hostcategory         = "all"
+  allow_command_groups = [freeipa_sudo_command_group.logs.name]
+}
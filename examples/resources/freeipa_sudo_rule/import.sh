@@ -0,0 +1,2 @@
+# Sudo rules can be imported by name
+terraform import freeipa_sudo_rule.admins_all admins_all
@@ -0,0 +1,20 @@
+resource "freeipa_sudo_rule" "admins_all" {
+  name               = "admins_all"
+  description        = "Admins may run any command as anyone on the web servers"
+  groups             = [freeipa_group.admins.name]
+  hostgroups         = [freeipa_hostgroup.webservers.name]
+  cmdcategory        = "all"
+  runasusercategory  = "all"
+  runasgroupcategory = "all"
+  options            = ["!authenticate"]
+}
+
+resource "freeipa_sudo_rule" "helpdesk_restart" {
+  name           = "helpdesk_restart"
+  sudo_order     = 10
+  groups         = [freeipa_group.helpdesk.name]
+  hostcategory   = "all"
+  allow_commands = ["/usr/bin/systemctl"]
+  deny_commands  = ["/usr/bin/su"]
+  runas_users    = ["root"]
+}
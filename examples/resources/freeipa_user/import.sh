@@ -0,0 +1,2 @@
+# Users can be imported by login
+terraform import freeipa_user.jdoe jdoe
@@ -0,0 +1,23 @@
+resource "freeipa_user" "jdoe" {
+  login           = "jdoe"
+  first_name      = "John"
+  last_name       = "Doe"
+  email           = ["john.doe@corp.example.com"]
+  login_shell     = "/bin/bash"
+  title           = "Systems Engineer"
+  manager         = "asmith"
+  employee_type   = "contractor"
+  ssh_public_keys = [file("~/.ssh/id_ed25519.pub")]
+  user_auth_types = ["password", "otp"]
+  password        = var.initial_password
+}
+
+# New hires are staged by HR and activated on their first day, leavers are
+# preserved so that their account can be restored.
+resource "freeipa_user" "new_hire" {
+  login               = "asmith"
+  first_name          = "Alice"
+  last_name           = "Smith"
+  state               = "staged"
+  preserve_on_destroy = true
+}
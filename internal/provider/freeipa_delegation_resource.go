@@ -0,0 +1,266 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDelegationResource{}
+var _ resource.ResourceWithImportState = &FreeipaDelegationResource{}
+
+func NewFreeipaDelegationResource() resource.Resource {
+	return &FreeipaDelegationResource{}
+}
+
+type FreeipaDelegationResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaDelegationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Attributes  types.Set    `tfsdk:"attributes"`
+	Group       types.String `tfsdk:"group"`
+	Membergroup types.String `tfsdk:"membergroup"`
+}
+
+func (r *FreeipaDelegationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_delegation"
+}
+
+func (r *FreeipaDelegationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa delegation rule resource. Delegation rules allow the members of a group to edit attributes of the members of another group",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "delegation rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the delegation rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": aciPermissionsAttribute(),
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes the members of `group` may edit. Attribute names are case-insensitive, their configured spelling is kept",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Name of the group granted the permissions",
+				Required:            true,
+			},
+			"membergroup": schema.StringAttribute{
+				MarkdownDescription: "Name of the group whose members' entries may be edited",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaDelegationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromDelegation copies the attributes of a FreeIPA delegation rule into the model.
+func (data *FreeipaDelegationResourceModel) setFromDelegation(ctx context.Context, rule *freeipa.Delegation) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(rule.Aciname)
+	data.Name = types.StringValue(rule.Aciname)
+	data.Group = types.StringValue(rule.Group)
+	data.Membergroup = types.StringValue(rule.Memberof)
+	data.Permissions, d = stringSetValueOrNull(ctx, rule.Permissions)
+	diags.Append(d...)
+	known, d := stringSetElements(ctx, data.Attributes)
+	diags.Append(d...)
+	attributes := matchAttributes(rule.Attrs, known)
+	data.Attributes, d = stringSetValueOrNull(ctx, &attributes)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *FreeipaDelegationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDelegationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := stringSetElements(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+	attributes, diags := stringSetElements(ctx, data.Attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.DelegationAdd(&freeipa.DelegationAddArgs{
+		Aciname:  data.Name.ValueString(),
+		Attrs:    attributes,
+		Group:    data.Group.ValueString(),
+		Memberof: data.Membergroup.ValueString(),
+	}, &freeipa.DelegationAddOptionalArgs{
+		Permissions: &permissions,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.setFromDelegation(ctx, &rule.Result)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("created delegation rule: %s", data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDelegationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDelegationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.DelegationShow(&freeipa.DelegationShowArgs{
+		Aciname: state.Name.ValueString(),
+	}, &freeipa.DelegationShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("delegation rule %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read delegation rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDelegation(ctx, &rule.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDelegationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDelegationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.DelegationModOptionalArgs{}
+	changed := false
+	if !plan.Permissions.Equal(state.Permissions) {
+		permissions, diags := stringSetElements(ctx, plan.Permissions)
+		resp.Diagnostics.Append(diags...)
+		optArgs.Permissions = &permissions
+		changed = true
+	}
+	if !plan.Attributes.Equal(state.Attributes) {
+		attributes, diags := stringSetElements(ctx, plan.Attributes)
+		resp.Diagnostics.Append(diags...)
+		optArgs.Attrs = &attributes
+		changed = true
+	}
+	if !plan.Group.Equal(state.Group) {
+		optArgs.Group = utils.RefString(plan.Group.ValueString())
+		changed = true
+	}
+	if !plan.Membergroup.Equal(state.Membergroup) {
+		optArgs.Memberof = utils.RefString(plan.Membergroup.ValueString())
+		changed = true
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if changed {
+		rule, err := r.client.DelegationMod(&freeipa.DelegationModArgs{
+			Aciname: state.Name.ValueString(),
+		}, optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update delegation rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+		resp.Diagnostics.Append(state.setFromDelegation(ctx, &rule.Result)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDelegationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDelegationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.DelegationDel(&freeipa.DelegationDelArgs{
+		Aciname: data.Name.ValueString(),
+	}, &freeipa.DelegationDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDelegationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
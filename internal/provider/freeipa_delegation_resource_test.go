@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDelegationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDelegationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_delegation.test", "id", "tftestdelegation"),
+					resource.TestCheckResourceAttr("freeipa_delegation.test", "group", "tftestdelegationeditors"),
+					resource.TestCheckResourceAttr("freeipa_delegation.test", "membergroup", "tftestdelegationmembers"),
+					resource.TestCheckTypeSetElemAttr("freeipa_delegation.test", "attributes.*", "telephonenumber"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_delegation.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestdelegation",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDelegationResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_delegation.test", "attributes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_delegation.test", "attributes.*", "telephoneNumber"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDelegationResourceGroups = `
+resource "freeipa_group" "editors" {
+  name = "tftestdelegationeditors"
+}
+
+resource "freeipa_group" "members" {
+  name = "tftestdelegationmembers"
+}
+`
+
+const testAccFreeipaDelegationResourceConfig = testAccFreeipaDelegationResourceGroups + `
+resource "freeipa_delegation" "test" {
+  name        = "tftestdelegation"
+  attributes  = ["telephonenumber"]
+  group       = freeipa_group.editors.name
+  membergroup = freeipa_group.members.name
+}
+`
+
+const testAccFreeipaDelegationResourceConfigUpdate = testAccFreeipaDelegationResourceGroups + `
+resource "freeipa_delegation" "test" {
+  name        = "tftestdelegation"
+  attributes  = ["telephoneNumber", "mobile"]
+  group       = freeipa_group.editors.name
+  membergroup = freeipa_group.members.name
+}
+`
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsConfigResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsConfigResource{}
+
+// dnsConfigId is the identifier of the global DNS configuration, of which
+// there is a single instance.
+const dnsConfigId = "dnsconfig"
+
+func NewFreeipaDnsConfigResource() resource.Resource {
+	return &FreeipaDnsConfigResource{}
+}
+
+// FreeipaDnsConfigResource manages the global DNS configuration through the
+// raw JSON-RPC client, as go-freeipa omits the empty values needed to clear
+// its attributes.
+type FreeipaDnsConfigResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsConfigResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Forwarders    types.Set    `tfsdk:"forwarders"`
+	ForwardPolicy types.String `tfsdk:"forward_policy"`
+	AllowSyncPtr  types.Bool   `tfsdk:"allow_sync_ptr"`
+	ZoneRefresh   types.Int64  `tfsdk:"zone_refresh"`
+}
+
+func (r *FreeipaDnsConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_config"
+}
+
+func (r *FreeipaDnsConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa global DNS configuration resource. There is a single configuration per IPA domain: destroying this resource restores the defaults",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS configuration identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"forwarders": schema.SetAttribute{
+				MarkdownDescription: "Global forwarders, such as `192.0.2.53` or `192.0.2.53 port 5353`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"forward_policy": schema.StringAttribute{
+				MarkdownDescription: "Global forwarding policy, one of `first`, `only` or `none`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("first", "only", "none"),
+				},
+			},
+			"allow_sync_ptr": schema.BoolAttribute{
+				MarkdownDescription: "Allow the synchronization of PTR records with A and AAAA records in all zones. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"zone_refresh": schema.Int64Attribute{
+				MarkdownDescription: "Interval between the checks for new DNS zones, in seconds",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaDnsConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// options returns the options of dnsconfig_mod turning the configuration
+// described by state into the one described by data.
+func (data *FreeipaDnsConfigResourceModel) options(ctx context.Context, state *FreeipaDnsConfigResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := map[string]interface{}{}
+
+	for _, o := range []struct {
+		name        string
+		plan, state attr.Value
+	}{
+		{"idnsforwarders", data.Forwarders, state.Forwarders},
+		{"idnsforwardpolicy", data.ForwardPolicy, state.ForwardPolicy},
+		{"idnsallowsyncptr", data.AllowSyncPtr, state.AllowSyncPtr},
+		{"idnszonerefresh", data.ZoneRefresh, state.ZoneRefresh},
+	} {
+		diags.Append(setRPCOption(ctx, options, o.name, o.plan, o.state)...)
+	}
+
+	return options, diags
+}
+
+// setFromDnsConfig copies the attributes of the FreeIPA DNS configuration
+// into the model.
+func (data *FreeipaDnsConfigResourceModel) setFromDnsConfig(ctx context.Context, config rpcEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(dnsConfigId)
+	forwarders := config.values("idnsforwarders")
+	data.Forwarders, diags = membersSetValue(ctx, data.Forwarders, &forwarders)
+	data.ForwardPolicy = types.StringPointerValue(config.value("idnsforwardpolicy"))
+	data.ZoneRefresh = types.Int64PointerValue(config.int64Value("idnszonerefresh"))
+	data.AllowSyncPtr = types.BoolValue(false)
+	if v := config.boolValue("idnsallowsyncptr"); v != nil {
+		data.AllowSyncPtr = types.BoolValue(*v)
+	}
+
+	return diags
+}
+
+// showDnsConfig retrieves the DNS configuration.
+func (r *FreeipaDnsConfigResource) showDnsConfig() (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("dnsconfig_show", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// modDnsConfig modifies the DNS configuration with the given options.
+func (r *FreeipaDnsConfigResource) modDnsConfig(options map[string]interface{}) error {
+	if len(options) == 0 {
+		return nil
+	}
+	err := r.rpc.call("dnsconfig_mod", nil, options, nil)
+	if isFreeipaError(err, freeipaErrEmptyModlist) {
+		return nil
+	}
+	return err
+}
+
+func (r *FreeipaDnsConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration always exists: compare the plan with its current
+	// values so that the attributes left out of the plan are cleared.
+	config, err := r.showDnsConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the DNS configuration, got error: %s", err))
+		return
+	}
+	var current FreeipaDnsConfigResourceModel
+	resp.Diagnostics.Append(current.setFromDnsConfig(ctx, config)...)
+	options, diags := data.options(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modDnsConfig(options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure DNS, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "configured DNS")
+
+	config, err = r.showDnsConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the DNS configuration, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromDnsConfig(ctx, config)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config, err := r.showDnsConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the DNS configuration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDnsConfig(ctx, config)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDnsConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := plan.options(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modDnsConfig(options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the DNS configuration, got error: %s", err))
+		return
+	}
+
+	config, err := r.showDnsConfig()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the DNS configuration, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromDnsConfig(ctx, config)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The configuration cannot be deleted, clear its attributes instead.
+	defaults := FreeipaDnsConfigResourceModel{
+		Forwarders:    types.SetNull(types.StringType),
+		ForwardPolicy: types.StringNull(),
+		AllowSyncPtr:  types.BoolNull(),
+		ZoneRefresh:   types.Int64Null(),
+	}
+	options, diags := defaults.options(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modDnsConfig(options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore the default DNS configuration, got error: %s", err))
+		return
+	}
+}
+
+func (r *FreeipaDnsConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != dnsConfigId {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier %q. Got: %q", dnsConfigId, req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsConfigResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsConfigResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_config.test", "id", "dnsconfig"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_config.test", "forwarders.*", "192.0.2.53"),
+					resource.TestCheckResourceAttr("freeipa_dns_config.test", "forward_policy", "first"),
+					resource.TestCheckResourceAttr("freeipa_dns_config.test", "allow_sync_ptr", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "freeipa_dns_config.test",
+				ImportState:       true,
+				ImportStateId:     "dnsconfig",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDnsConfigResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_dns_config.test", "forwarders"),
+					resource.TestCheckNoResourceAttr("freeipa_dns_config.test", "forward_policy"),
+					resource.TestCheckResourceAttr("freeipa_dns_config.test", "allow_sync_ptr", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsConfigResourceConfig = `
+resource "freeipa_dns_config" "test" {
+  forwarders     = ["192.0.2.53"]
+  forward_policy = "first"
+}
+`
+
+const testAccFreeipaDnsConfigResourceConfigUpdate = `
+resource "freeipa_dns_config" "test" {
+  allow_sync_ptr = true
+}
+`
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsForwardZoneResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsForwardZoneResource{}
+
+func NewFreeipaDnsForwardZoneResource() resource.Resource {
+	return &FreeipaDnsForwardZoneResource{}
+}
+
+// FreeipaDnsForwardZoneResource manages DNS forward zones through the raw
+// JSON-RPC client, as go-freeipa cannot unmarshal forward zone entries.
+type FreeipaDnsForwardZoneResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsForwardZoneResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Forwarders       types.Set    `tfsdk:"forwarders"`
+	ForwardPolicy    types.String `tfsdk:"forward_policy"`
+	SkipOverlapCheck types.Bool   `tfsdk:"skip_overlap_check"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *FreeipaDnsForwardZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_forward_zone"
+}
+
+func (r *FreeipaDnsForwardZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS forward zone resource. Queries for names of a forward zone are forwarded to its forwarders",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS forward zone identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the forward zone, such as `ad.example.com`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"forwarders": schema.SetAttribute{
+				MarkdownDescription: "Forwarders of the zone, such as `192.0.2.53` or `192.0.2.53 port 5353`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"forward_policy": schema.StringAttribute{
+				MarkdownDescription: "Forwarding policy, one of `first`, `only` or `none`. Defaults to `first`",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("first", "only", "none"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_overlap_check": schema.BoolAttribute{
+				MarkdownDescription: "Create the zone even if it overlaps with an existing zone",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is active. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FreeipaDnsForwardZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// setFromDnsForwardZone copies the attributes of a FreeIPA DNS forward zone
+// entry into the model.
+func (data *FreeipaDnsForwardZoneResourceModel) setFromDnsForwardZone(ctx context.Context, zone rpcEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringPointerValue(zone.value("idnsname"))
+	data.Name = dnsNameValue(data.Name, zone.value("idnsname"))
+	forwarders := zone.values("idnsforwarders")
+	data.Forwarders, diags = membersSetValue(ctx, data.Forwarders, &forwarders)
+	data.ForwardPolicy = types.StringPointerValue(zone.value("idnsforwardpolicy"))
+	data.Enabled = types.BoolValue(true)
+	if v := zone.boolValue("idnszoneactive"); v != nil {
+		data.Enabled = types.BoolValue(*v)
+	}
+
+	return diags
+}
+
+// setEnabled enables or disables a DNS forward zone.
+func (r *FreeipaDnsForwardZoneResource) setEnabled(name string, enabled bool) error {
+	if enabled {
+		err := r.rpc.call("dnsforwardzone_enable", []interface{}{name}, nil, nil)
+		if isFreeipaError(err, freeipaErrAlreadyActive) {
+			return nil
+		}
+		return err
+	}
+	err := r.rpc.call("dnsforwardzone_disable", []interface{}{name}, nil, nil)
+	if isFreeipaError(err, freeipaErrAlreadyInactive) {
+		return nil
+	}
+	return err
+}
+
+// showDnsForwardZone retrieves a DNS forward zone entry.
+func (r *FreeipaDnsForwardZoneResource) showDnsForwardZone(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("dnsforwardzone_show", []interface{}{name}, map[string]interface{}{"all": true}, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaDnsForwardZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsForwardZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "idnsforwarders", data.Forwarders, nil)...)
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "idnsforwardpolicy", data.ForwardPolicy, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.SkipOverlapCheck.ValueBool() {
+		options["skip_overlap_check"] = true
+	}
+
+	name := data.Name.ValueString()
+	if err := r.rpc.call("dnsforwardzone_add", []interface{}{name}, options, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS forward zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created DNS forward zone: %s", name))
+
+	if !data.Enabled.ValueBool() {
+		if err := r.setEnabled(name, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable DNS forward zone %s, got error: %s", data.Name.String(), err))
+			return
+		}
+	}
+
+	zone, err := r.showDnsForwardZone(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS forward zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromDnsForwardZone(ctx, zone)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsForwardZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsForwardZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.showDnsForwardZone(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("DNS forward zone %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS forward zone %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDnsForwardZone(ctx, zone)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsForwardZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDnsForwardZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "idnsforwarders", plan.Forwarders, state.Forwarders)...)
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "idnsforwardpolicy", plan.ForwardPolicy, state.ForwardPolicy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if len(options) > 0 {
+		err := r.rpc.call("dnsforwardzone_mod", []interface{}{name}, options, nil)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS forward zone %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := r.setEnabled(name, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change the state of DNS forward zone %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	zone, err := r.showDnsForwardZone(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS forward zone %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromDnsForwardZone(ctx, zone)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsForwardZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsForwardZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("dnsforwardzone_del", []interface{}{[]string{data.Name.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS forward zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDnsForwardZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
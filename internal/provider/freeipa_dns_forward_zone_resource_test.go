@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsForwardZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsForwardZoneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "id", "tftest-forward.example.test."),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_forward_zone.test", "forwarders.*", "192.0.2.53"),
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "forward_policy", "first"),
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "enabled", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_dns_forward_zone.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftest-forward.example.test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"skip_overlap_check"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDnsForwardZoneResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "forwarders.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "forward_policy", "only"),
+					resource.TestCheckResourceAttr("freeipa_dns_forward_zone.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsForwardZoneResourceConfig = `
+resource "freeipa_dns_forward_zone" "test" {
+  name               = "tftest-forward.example.test"
+  forwarders         = ["192.0.2.53"]
+  skip_overlap_check = true
+}
+`
+
+const testAccFreeipaDnsForwardZoneResourceConfigUpdate = `
+resource "freeipa_dns_forward_zone" "test" {
+  name               = "tftest-forward.example.test"
+  forwarders         = ["192.0.2.53", "192.0.2.54 port 5353"]
+  forward_policy     = "only"
+  skip_overlap_check = true
+  enabled            = false
+}
+`
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FreeipaDnsRecordDataSource{}
+var _ datasource.DataSourceWithConfigure = &FreeipaDnsRecordDataSource{}
+
+func NewFreeipaDnsRecordDataSource() datasource.DataSource {
+	return &FreeipaDnsRecordDataSource{}
+}
+
+// FreeipaDnsRecordDataSource reads DNS records through the raw JSON-RPC
+// client, as go-freeipa cannot unmarshal record entries.
+type FreeipaDnsRecordDataSource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+func (d *FreeipaDnsRecordDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+// dnsRecordDataSourceAttributes returns the computed attributes describing
+// the records of a name, shared by the DNS record data sources.
+func dnsRecordDataSourceAttributes() map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"ttl": schema.Int64Attribute{
+			MarkdownDescription: "Time to live of the records, in seconds",
+			Computed:            true,
+		},
+	}
+	for _, t := range dnsRecordTypes {
+		attributes[t.attribute] = schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("%s records of the name, such as `%s`", t.label, t.example),
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+	return attributes
+}
+
+func (d *FreeipaDnsRecordDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := dnsRecordDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Id of the record, in the `zone/name` format",
+		Computed:            true,
+	}
+	attributes["zone"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the DNS zone holding the record",
+		Required:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the record, relative to the zone, such as `_kerberos._udp`. Use `@` for the apex of the zone",
+		Required:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS record data source. Returns the records of all types of a name",
+		Attributes:          attributes,
+	}
+}
+
+func (d *FreeipaDnsRecordDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.rpc = data.rpc
+}
+
+// dnsRecordAttributeTypes returns the types of the attributes returned by
+// dnsRecordDataSourceAttributes.
+func dnsRecordAttributeTypes() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{
+		"ttl": types.Int64Type,
+	}
+	for _, t := range dnsRecordTypes {
+		attributeTypes[t.attribute] = types.SetType{ElemType: types.StringType}
+	}
+	return attributeTypes
+}
+
+// dnsRecordAttributeValues returns the TTL and the records of a FreeIPA DNS
+// record entry, keyed by the attributes returned by
+// dnsRecordDataSourceAttributes. Record types the name does not hold are null.
+func dnsRecordAttributeValues(ctx context.Context, record rpcEntry) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values := map[string]attr.Value{
+		"ttl": types.Int64PointerValue(record.int64Value("dnsttl")),
+	}
+	for _, t := range dnsRecordTypes {
+		records := record.values(t.ipaAttribute)
+		set, d := stringSetValueOrNull(ctx, &records)
+		diags.Append(d...)
+		values[t.attribute] = set
+	}
+
+	return values, diags
+}
+
+func (d *FreeipaDnsRecordDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var zone, name types.String
+
+	// Read Terraform configuration data, the record attributes being built
+	// from dnsRecordTypes
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("name"), &name)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var res rpcEntryResult
+	err := d.rpc.call("dnsrecord_show", []interface{}{zone.ValueString(), name.ValueString()}, nil, &res)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record %s in zone %s, got error: %s", name.String(), zone.String(), err))
+		return
+	}
+
+	values, diags := dnsRecordAttributeValues(ctx, res.Result)
+	resp.Diagnostics.Append(diags...)
+	values["id"] = types.StringValue(zone.ValueString() + "/" + name.ValueString())
+	values["zone"] = zone
+	values["name"] = name
+
+	tflog.Trace(ctx, "read a data source")
+
+	for attribute, value := range values {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), value)...)
+	}
+}
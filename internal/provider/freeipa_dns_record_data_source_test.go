@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsRecordDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFreeipaDnsRecordDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_dns_record.test", "id", "tftest-recordds.example.test/_kerberos._udp"),
+					resource.TestCheckTypeSetElemAttr("data.freeipa_dns_record.test", "srv_records.*", "0 100 88 kdc.tftest-recordds.example.test."),
+					resource.TestCheckResourceAttr("data.freeipa_dns_record.test", "ttl", "600"),
+					resource.TestCheckNoResourceAttr("data.freeipa_dns_record.test", "a_records"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFreeipaDnsRecordDataSourceConfig = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-recordds.example.test"
+  skip_overlap_check = true
+}
+
+resource "freeipa_dns_record" "test" {
+  zone        = freeipa_dns_zone.test.name
+  name        = "_kerberos._udp"
+  ttl         = 600
+  srv_records = ["0 100 88 kdc.tftest-recordds.example.test."]
+}
+
+data "freeipa_dns_record" "test" {
+  zone = freeipa_dns_record.test.zone
+  name = freeipa_dns_record.test.name
+}
+`
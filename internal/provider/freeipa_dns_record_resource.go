@@ -0,0 +1,426 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsRecordResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsRecordResource{}
+
+// dnsRecordImportedKey is the private state key set on import so that the
+// next read adopts all the record types of the name.
+const dnsRecordImportedKey = "imported"
+
+// dnsRecordTypes lists the record types supported by the DNS record resource
+// and data sources, with their FreeIPA attribute and an example value.
+var dnsRecordTypes = []struct {
+	attribute, ipaAttribute, label, example string
+}{
+	{"a_records", "arecord", "A", "192.0.2.1"},
+	{"aaaa_records", "aaaarecord", "AAAA", "2001:db8::1"},
+	{"cname_records", "cnamerecord", "CNAME", "www.example.com."},
+	{"ptr_records", "ptrrecord", "PTR", "host.example.com."},
+	{"mx_records", "mxrecord", "MX", "10 mail.example.com."},
+	{"srv_records", "srvrecord", "SRV", "0 100 389 ldap.example.com."},
+	{"txt_records", "txtrecord", "TXT", "v=spf1 mx -all"},
+	{"caa_records", "caarecord", "CAA", "0 issue \"letsencrypt.org\""},
+	{"sshfp_records", "sshfprecord", "SSHFP", "4 2 123456789abcdef..."},
+	{"tlsa_records", "tlsarecord", "TLSA", "3 1 1 0123456789abcdef..."},
+	{"ns_records", "nsrecord", "NS", "ns1.example.com."},
+	{"ds_records", "dsrecord", "DS", "12345 13 2 0123456789abcdef..."},
+}
+
+func NewFreeipaDnsRecordResource() resource.Resource {
+	return &FreeipaDnsRecordResource{}
+}
+
+// FreeipaDnsRecordResource manages DNS records through the raw JSON-RPC
+// client, as go-freeipa cannot unmarshal record entries.
+type FreeipaDnsRecordResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsRecordResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	Zone         types.String `tfsdk:"zone"`
+	Name         types.String `tfsdk:"name"`
+	Ttl          types.Int64  `tfsdk:"ttl"`
+	ARecords     types.Set    `tfsdk:"a_records"`
+	AaaaRecords  types.Set    `tfsdk:"aaaa_records"`
+	CnameRecords types.Set    `tfsdk:"cname_records"`
+	PtrRecords   types.Set    `tfsdk:"ptr_records"`
+	MxRecords    types.Set    `tfsdk:"mx_records"`
+	SrvRecords   types.Set    `tfsdk:"srv_records"`
+	TxtRecords   types.Set    `tfsdk:"txt_records"`
+	CaaRecords   types.Set    `tfsdk:"caa_records"`
+	SshfpRecords types.Set    `tfsdk:"sshfp_records"`
+	TlsaRecords  types.Set    `tfsdk:"tlsa_records"`
+	NsRecords    types.Set    `tfsdk:"ns_records"`
+	DsRecords    types.Set    `tfsdk:"ds_records"`
+}
+
+func (r *FreeipaDnsRecordResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+func (r *FreeipaDnsRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "DNS record identifier, in the form `zone/name`",
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"zone": schema.StringAttribute{
+			MarkdownDescription: "Name of the zone of the record",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Name of the record, relative to the zone. Use `@` for the zone apex",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"ttl": schema.Int64Attribute{
+			MarkdownDescription: "Time to live of the records of the name, in seconds. When unset, the TTL of the name is left alone",
+			Optional:            true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(0),
+			},
+		},
+	}
+	for _, t := range dnsRecordTypes {
+		attribute := schema.SetAttribute{
+			MarkdownDescription: fmt.Sprintf("%s records of the name, such as `%s`. When set, values missing from the configuration are removed; when unset, the %s records of the name are left alone", t.label, t.example, t.label),
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+		if t.label == "CNAME" {
+			attribute.Validators = []validator.Set{setvalidator.SizeAtMost(1)}
+		}
+		attributes[t.attribute] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS record resource. It manages the records of the configured types of a name, other record types of the same name are left alone",
+		Attributes:          attributes,
+	}
+}
+
+func (r *FreeipaDnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// recordSets returns the record sets of the model keyed by FreeIPA attribute.
+func (data *FreeipaDnsRecordResourceModel) recordSets() map[string]*types.Set {
+	return map[string]*types.Set{
+		"arecord":     &data.ARecords,
+		"aaaarecord":  &data.AaaaRecords,
+		"cnamerecord": &data.CnameRecords,
+		"ptrrecord":   &data.PtrRecords,
+		"mxrecord":    &data.MxRecords,
+		"srvrecord":   &data.SrvRecords,
+		"txtrecord":   &data.TxtRecords,
+		"caarecord":   &data.CaaRecords,
+		"sshfprecord": &data.SshfpRecords,
+		"tlsarecord":  &data.TlsaRecords,
+		"nsrecord":    &data.NsRecords,
+		"dsrecord":    &data.DsRecords,
+	}
+}
+
+// records returns the values of the managed record types, those whose set is
+// not null, keyed by FreeIPA attribute.
+func (data *FreeipaDnsRecordResourceModel) records(ctx context.Context) (map[string][]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	records := map[string][]string{}
+	for attribute, set := range data.recordSets() {
+		if set.IsNull() || set.IsUnknown() {
+			continue
+		}
+		values, d := stringSetElements(ctx, *set)
+		diags.Append(d...)
+		records[attribute] = values
+	}
+	return records, diags
+}
+
+// setFromDnsRecord copies the attributes of a FreeIPA DNS record entry into
+// the model. Only the managed record types are set, unless all is true.
+func (data *FreeipaDnsRecordResourceModel) setFromDnsRecord(ctx context.Context, record rpcEntry, all bool) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(data.Zone.ValueString() + "/" + data.Name.ValueString())
+	if !data.Ttl.IsNull() || all {
+		data.Ttl = types.Int64PointerValue(record.int64Value("dnsttl"))
+	}
+	for attribute, set := range data.recordSets() {
+		values := record.values(attribute)
+		if set.IsNull() && !(all && len(values) > 0) {
+			continue
+		}
+		*set, d = membersSetValue(ctx, *set, &values)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// showDnsRecord retrieves a DNS record entry.
+func (r *FreeipaDnsRecordResource) showDnsRecord(zone, name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("dnsrecord_show", []interface{}{zone, name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// reconcile adds and removes record values so that the managed record types
+// of a name hold exactly the wanted values.
+func (r *FreeipaDnsRecordResource) reconcile(zone, name string, want map[string][]string) error {
+	record, err := r.showDnsRecord(zone, name)
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	add, remove := map[string]interface{}{}, map[string]interface{}{}
+	for attribute, values := range want {
+		toAdd, toRemove := utils.Diff(record.values(attribute), values)
+		if len(toAdd) > 0 {
+			add[attribute] = toAdd
+		}
+		if len(toRemove) > 0 {
+			remove[attribute] = toRemove
+		}
+	}
+
+	// Removing first lets a CNAME record replace other records and vice versa
+	if len(remove) > 0 {
+		err := r.rpc.call("dnsrecord_del", []interface{}{zone, name}, remove, nil)
+		if err != nil && !isNotFound(err) && !isFreeipaError(err, freeipaErrAttrValueNotFound) {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		if err := r.rpc.call("dnsrecord_add", []interface{}{zone, name}, add, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setTtl sets the time to live of a name.
+func (r *FreeipaDnsRecordResource) setTtl(zone, name string, ttl int64) error {
+	err := r.rpc.call("dnsrecord_mod", []interface{}{zone, name}, map[string]interface{}{"dnsttl": ttl}, nil)
+	if isFreeipaError(err, freeipaErrEmptyModlist) {
+		return nil
+	}
+	return err
+}
+
+func (r *FreeipaDnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := data.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, name := data.Zone.ValueString(), data.Name.ValueString()
+	if err := r.reconcile(zone, name, records); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS record %s in zone %s, got error: %s", data.Name.String(), data.Zone.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created DNS record: %s/%s", zone, name))
+
+	if !data.Ttl.IsNull() {
+		if err := r.setTtl(zone, name, data.Ttl.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the TTL of DNS record %s in zone %s, got error: %s", data.Name.String(), data.Zone.String(), err))
+			return
+		}
+	}
+
+	record, err := r.showDnsRecord(zone, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record %s in zone %s, got error: %s", data.Name.String(), data.Zone.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromDnsRecord(ctx, record, false)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	imported, diags := req.Private.GetKey(ctx, dnsRecordImportedKey)
+	resp.Diagnostics.Append(diags...)
+
+	record, err := r.showDnsRecord(state.Zone.ValueString(), state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("DNS record %s not found in zone %s, removing it from the state", state.Name.ValueString(), state.Zone.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record %s in zone %s, got error: %s", state.Name.String(), state.Zone.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDnsRecord(ctx, record, imported != nil)...)
+	if imported != nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, dnsRecordImportedKey, nil)...)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDnsRecordResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := plan.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, name := state.Zone.ValueString(), state.Name.ValueString()
+	if err := r.reconcile(zone, name, records); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS record %s in zone %s, got error: %s", state.Name.String(), state.Zone.String(), err))
+		return
+	}
+
+	if !plan.Ttl.IsNull() && !plan.Ttl.Equal(state.Ttl) {
+		if err := r.setTtl(zone, name, plan.Ttl.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the TTL of DNS record %s in zone %s, got error: %s", state.Name.String(), state.Zone.String(), err))
+			return
+		}
+	}
+
+	record, err := r.showDnsRecord(zone, name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS record %s in zone %s, got error: %s", state.Name.String(), state.Zone.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromDnsRecord(ctx, record, false)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsRecordResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, diags := data.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only remove the managed values, the name may hold other records
+	options := map[string]interface{}{}
+	for attribute, values := range records {
+		if len(values) > 0 {
+			options[attribute] = values
+		}
+	}
+	if len(options) == 0 {
+		return
+	}
+
+	err := r.rpc.call("dnsrecord_del", []interface{}{data.Zone.ValueString(), data.Name.ValueString()}, options, nil)
+	if err != nil && !isNotFound(err) && !isFreeipaError(err, freeipaErrAttrValueNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS record %s in zone %s, got error: %s", data.Name.String(), data.Zone.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: zone/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	// Adopt all the record types of the name on the following read
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dnsRecordImportedKey, []byte("true"))...)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsRecordResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_record.test", "id", "tftest-record.example.test/www"),
+					resource.TestCheckResourceAttr("freeipa_dns_record.test", "ttl", "300"),
+					resource.TestCheckResourceAttr("freeipa_dns_record.test", "a_records.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_record.test", "txt_records.*", "tftest"),
+					resource.TestCheckNoResourceAttr("freeipa_dns_record.test", "mx_records"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_record.mx", "mx_records.*", "10 mail.tftest-record.example.test."),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_dns_record.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftest-record.example.test/www",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "id",
+				// Importing adopts the MX records managed by freeipa_dns_record.mx
+				ImportStateVerifyIgnore: []string{"mx_records"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDnsRecordResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_dns_record.test", "ttl"),
+					resource.TestCheckResourceAttr("freeipa_dns_record.test", "a_records.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_record.test", "a_records.*", "192.0.2.12"),
+					resource.TestCheckResourceAttr("freeipa_dns_record.test", "txt_records.#", "0"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_record.mx", "mx_records.*", "10 mail.tftest-record.example.test."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsRecordResourcePrerequisites = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-record.example.test"
+  skip_overlap_check = true
+}
+
+resource "freeipa_dns_record" "mx" {
+  zone       = freeipa_dns_zone.test.name
+  name       = "www"
+  mx_records = ["10 mail.tftest-record.example.test."]
+}
+`
+
+const testAccFreeipaDnsRecordResourceConfig = testAccFreeipaDnsRecordResourcePrerequisites + `
+resource "freeipa_dns_record" "test" {
+  zone        = freeipa_dns_zone.test.name
+  name        = "www"
+  ttl         = 300
+  a_records   = ["192.0.2.10", "192.0.2.11"]
+  txt_records = ["tftest"]
+}
+`
+
+const testAccFreeipaDnsRecordResourceConfigUpdate = testAccFreeipaDnsRecordResourcePrerequisites + `
+resource "freeipa_dns_record" "test" {
+  zone        = freeipa_dns_zone.test.name
+  name        = "www"
+  a_records   = ["192.0.2.12"]
+  txt_records = []
+}
+`
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FreeipaDnsRecordsDataSource{}
+var _ datasource.DataSourceWithConfigure = &FreeipaDnsRecordsDataSource{}
+
+func NewFreeipaDnsRecordsDataSource() datasource.DataSource {
+	return &FreeipaDnsRecordsDataSource{}
+}
+
+// FreeipaDnsRecordsDataSource lists DNS records through the raw JSON-RPC
+// client, as go-freeipa cannot unmarshal record entries.
+type FreeipaDnsRecordsDataSource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsRecordsDataSourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	Zone   types.String `tfsdk:"zone"`
+	Search types.String `tfsdk:"search"`
+	// Records holds objects of the attributes built from dnsRecordTypes
+	Records types.List `tfsdk:"records"`
+}
+
+func (d *FreeipaDnsRecordsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_records"
+}
+
+func (d *FreeipaDnsRecordsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	recordAttributes := dnsRecordDataSourceAttributes()
+	recordAttributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the record, relative to the zone. The apex of the zone is named `@`",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS records data source. Lists the records of a DNS zone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the search, the name of the zone",
+				Computed:            true,
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS zone",
+				Required:            true,
+			},
+			"search": schema.StringAttribute{
+				MarkdownDescription: "Only list the records whose name or values contain this string",
+				Optional:            true,
+			},
+			"records": schema.ListNestedAttribute{
+				MarkdownDescription: "Records of the zone, by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (d *FreeipaDnsRecordsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.rpc = data.rpc
+}
+
+func (d *FreeipaDnsRecordsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FreeipaDnsRecordsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	args := []interface{}{data.Zone.ValueString()}
+	if !data.Search.IsNull() {
+		args = append(args, data.Search.ValueString())
+	}
+	var res rpcFindResult
+	if err := d.rpc.call("dnsrecord_find", args, map[string]interface{}{"sizelimit": 0}, &res); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list the DNS records of zone %s, got error: %s", data.Zone.String(), err))
+		return
+	}
+	if res.Truncated {
+		resp.Diagnostics.AddWarning("Truncated Result", fmt.Sprintf("FreeIPA returned %d records of zone %s, more exist", res.Count, data.Zone.String()))
+	}
+
+	recordType := types.ObjectType{AttrTypes: dnsRecordAttributeTypes()}
+	recordType.AttrTypes["name"] = types.StringType
+
+	records := make([]attr.Value, 0, len(res.Result))
+	for _, entry := range res.Result {
+		values, diags := dnsRecordAttributeValues(ctx, entry)
+		resp.Diagnostics.Append(diags...)
+		values["name"] = types.StringPointerValue(entry.value("idnsname"))
+
+		record, diags := types.ObjectValue(recordType.AttrTypes, values)
+		resp.Diagnostics.Append(diags...)
+		records = append(records, record)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Id = data.Zone
+	data.Records, diags = types.ListValue(recordType, records)
+	resp.Diagnostics.Append(diags...)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
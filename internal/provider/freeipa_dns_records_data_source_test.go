@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsRecordsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFreeipaDnsRecordsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_dns_records.test", "id", "tftest-recordsds.example.test"),
+					resource.TestCheckResourceAttr("data.freeipa_dns_records.test", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.freeipa_dns_records.test", "records.0.name", "www"),
+					resource.TestCheckTypeSetElemAttr("data.freeipa_dns_records.test", "records.0.a_records.*", "192.0.2.10"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFreeipaDnsRecordsDataSourceConfig = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-recordsds.example.test"
+  skip_overlap_check = true
+}
+
+resource "freeipa_dns_record" "www" {
+  zone      = freeipa_dns_zone.test.name
+  name      = "www"
+  a_records = ["192.0.2.10"]
+}
+
+resource "freeipa_dns_record" "mail" {
+  zone      = freeipa_dns_zone.test.name
+  name      = "mail"
+  a_records = ["192.0.2.25"]
+}
+
+data "freeipa_dns_records" "test" {
+  zone   = freeipa_dns_zone.test.name
+  search = "www"
+
+  depends_on = [freeipa_dns_record.www, freeipa_dns_record.mail]
+}
+`
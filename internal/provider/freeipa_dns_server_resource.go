@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsServerResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsServerResource{}
+
+func NewFreeipaDnsServerResource() resource.Resource {
+	return &FreeipaDnsServerResource{}
+}
+
+// FreeipaDnsServerResource manages the configuration of an IPA DNS server
+// through the raw JSON-RPC client, as go-freeipa omits the empty values
+// needed to clear its attributes.
+type FreeipaDnsServerResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsServerResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	SoaMname      types.String `tfsdk:"soa_mname"`
+	Forwarders    types.Set    `tfsdk:"forwarders"`
+	ForwardPolicy types.String `tfsdk:"forward_policy"`
+}
+
+func (r *FreeipaDnsServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_server"
+}
+
+func (r *FreeipaDnsServerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS server resource. Manages the configuration of an existing IPA DNS server: destroying this resource restores the defaults",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS server identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified name of the IPA server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"soa_mname": schema.StringAttribute{
+				MarkdownDescription: "Authoritative nameserver put in the SOA record of the zones served by this server, overriding the server name",
+				Optional:            true,
+			},
+			"forwarders": schema.SetAttribute{
+				MarkdownDescription: "Forwarders of this server, such as `192.0.2.53` or `192.0.2.53 port 5353`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"forward_policy": schema.StringAttribute{
+				MarkdownDescription: "Forwarding policy of this server, one of `first`, `only` or `none`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("first", "only", "none"),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaDnsServerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// options returns the options of dnsserver_mod turning the configuration
+// described by state into the one described by data.
+func (data *FreeipaDnsServerResourceModel) options(ctx context.Context, state *FreeipaDnsServerResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := map[string]interface{}{}
+
+	for _, o := range []struct {
+		name        string
+		plan, state attr.Value
+	}{
+		{"idnssoamname", data.SoaMname, state.SoaMname},
+		{"idnsforwarders", data.Forwarders, state.Forwarders},
+		{"idnsforwardpolicy", data.ForwardPolicy, state.ForwardPolicy},
+	} {
+		diags.Append(setRPCOption(ctx, options, o.name, o.plan, o.state)...)
+	}
+
+	return options, diags
+}
+
+// setFromDnsServer copies the attributes of a FreeIPA DNS server entry into
+// the model.
+func (data *FreeipaDnsServerResourceModel) setFromDnsServer(ctx context.Context, server rpcEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringPointerValue(server.value("idnsserverid"))
+	data.Name = dnsNameValue(data.Name, server.value("idnsserverid"))
+	data.SoaMname = dnsNameValue(data.SoaMname, server.value("idnssoamname"))
+	forwarders := server.values("idnsforwarders")
+	data.Forwarders, diags = membersSetValue(ctx, data.Forwarders, &forwarders)
+	data.ForwardPolicy = types.StringPointerValue(server.value("idnsforwardpolicy"))
+
+	return diags
+}
+
+// showDnsServer retrieves a DNS server entry.
+func (r *FreeipaDnsServerResource) showDnsServer(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("dnsserver_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// modDnsServer modifies a DNS server with the given options.
+func (r *FreeipaDnsServerResource) modDnsServer(name string, options map[string]interface{}) error {
+	if len(options) == 0 {
+		return nil
+	}
+	err := r.rpc.call("dnsserver_mod", []interface{}{name}, options, nil)
+	if isFreeipaError(err, freeipaErrEmptyModlist) {
+		return nil
+	}
+	return err
+}
+
+func (r *FreeipaDnsServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsServerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DNS servers are created by the IPA installer: compare the plan with
+	// the current configuration so that the attributes left out of the plan
+	// are cleared.
+	server, err := r.showDnsServer(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS server %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	current := FreeipaDnsServerResourceModel{Name: data.Name}
+	resp.Diagnostics.Append(current.setFromDnsServer(ctx, server)...)
+	options, diags := data.options(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modDnsServer(data.Name.ValueString(), options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure DNS server %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("configured DNS server: %s", data.Name.ValueString()))
+
+	server, err = r.showDnsServer(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS server %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromDnsServer(ctx, server)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsServerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.showDnsServer(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("DNS server %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS server %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDnsServer(ctx, server)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDnsServerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := plan.options(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modDnsServer(state.Name.ValueString(), options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS server %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	server, err := r.showDnsServer(state.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS server %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromDnsServer(ctx, server)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsServerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// DNS servers are removed along with the IPA server, clear the
+	// attributes instead.
+	defaults := FreeipaDnsServerResourceModel{
+		SoaMname:      types.StringNull(),
+		Forwarders:    types.SetNull(types.StringType),
+		ForwardPolicy: types.StringNull(),
+	}
+	options, diags := defaults.options(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.modDnsServer(data.Name.ValueString(), options)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore the defaults of DNS server %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDnsServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
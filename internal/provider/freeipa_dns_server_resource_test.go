@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsServerResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsServerResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_server.test", "id", "duba-shp-doma01.corp.example.com"),
+					resource.TestCheckResourceAttr("freeipa_dns_server.test", "soa_mname", "ns1.corp.example.com."),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_server.test", "forwarders.*", "192.0.2.53"),
+					resource.TestCheckResourceAttr("freeipa_dns_server.test", "forward_policy", "only"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_dns_server.test",
+				ImportState:                          true,
+				ImportStateId:                        "duba-shp-doma01.corp.example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDnsServerResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_dns_server.test", "soa_mname"),
+					resource.TestCheckResourceAttr("freeipa_dns_server.test", "forwarders.#", "2"),
+					resource.TestCheckNoResourceAttr("freeipa_dns_server.test", "forward_policy"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsServerResourceConfig = `
+resource "freeipa_dns_server" "test" {
+  name           = "duba-shp-doma01.corp.example.com"
+  soa_mname      = "ns1.corp.example.com."
+  forwarders     = ["192.0.2.53"]
+  forward_policy = "only"
+}
+`
+
+const testAccFreeipaDnsServerResourceConfigUpdate = `
+resource "freeipa_dns_server" "test" {
+  name       = "duba-shp-doma01.corp.example.com"
+  forwarders = ["192.0.2.53", "192.0.2.54"]
+}
+`
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FreeipaDnsZoneDataSource{}
+var _ datasource.DataSourceWithConfigure = &FreeipaDnsZoneDataSource{}
+
+func NewFreeipaDnsZoneDataSource() datasource.DataSource {
+	return &FreeipaDnsZoneDataSource{}
+}
+
+// FreeipaDnsZoneDataSource reads DNS zones through the raw JSON-RPC client,
+// as go-freeipa cannot unmarshal zone entries.
+type FreeipaDnsZoneDataSource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsZoneDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	SoaMname    types.String `tfsdk:"soa_mname"`
+	SoaSerial   types.Int64  `tfsdk:"soa_serial"`
+	NameServers types.Set    `tfsdk:"name_servers"`
+	Dnssec      types.Bool   `tfsdk:"dnssec"`
+	Nsec3param  types.String `tfsdk:"nsec3param"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+}
+
+func (d *FreeipaDnsZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (d *FreeipaDnsZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS zone data source. Returns the delegation and DNSSEC settings of a zone. " +
+			"FreeIPA does not expose the DNSKEY records of signed zones: DS records for the parent zone have to be derived from the DNSKEY records served by the IPA DNS servers",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the zone",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone",
+				Required:            true,
+			},
+			"soa_mname": schema.StringAttribute{
+				MarkdownDescription: "Authoritative nameserver domain name of the SOA record",
+				Computed:            true,
+			},
+			"soa_serial": schema.Int64Attribute{
+				MarkdownDescription: "Serial number of the SOA record",
+				Computed:            true,
+			},
+			"name_servers": schema.SetAttribute{
+				MarkdownDescription: "NS records at the apex of the zone, to delegate the zone from its parent",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is signed with DNSSEC inline signing",
+				Computed:            true,
+			},
+			"nsec3param": schema.StringAttribute{
+				MarkdownDescription: "NSEC3PARAM record of the signed zone, null when NSEC is used",
+				Computed:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is active",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FreeipaDnsZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+	d.rpc = data.rpc
+}
+
+func (d *FreeipaDnsZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FreeipaDnsZoneDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var res rpcEntryResult
+	if err := d.rpc.call("dnszone_show", []interface{}{data.Name.ValueString()}, map[string]interface{}{"all": true}, &res); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	zone := res.Result
+
+	data.Id = types.StringPointerValue(zone.value("idnsname"))
+	data.SoaMname = types.StringPointerValue(zone.value("idnssoamname"))
+	data.SoaSerial = types.Int64PointerValue(zone.int64Value("idnssoaserial"))
+	nameServers := zone.values("nsrecord")
+	var diags diag.Diagnostics
+	data.NameServers, diags = stringSetValueOrNull(ctx, &nameServers)
+	resp.Diagnostics.Append(diags...)
+	data.Nsec3param = types.StringPointerValue(zone.value("nsec3paramrecord"))
+	data.Dnssec = types.BoolValue(false)
+	if v := zone.boolValue("idnssecinlinesigning"); v != nil {
+		data.Dnssec = types.BoolValue(*v)
+	}
+	data.Enabled = types.BoolValue(true)
+	if v := zone.boolValue("idnszoneactive"); v != nil {
+		data.Enabled = types.BoolValue(*v)
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFreeipaDnsZoneDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_dns_zone.test", "id", "tftest-zoneds.example.test."),
+					resource.TestCheckResourceAttr("data.freeipa_dns_zone.test", "dnssec", "true"),
+					resource.TestCheckNoResourceAttr("data.freeipa_dns_zone.test", "nsec3param"),
+					resource.TestCheckResourceAttrSet("data.freeipa_dns_zone.test", "name_servers.#"),
+					resource.TestCheckResourceAttrSet("data.freeipa_dns_zone.test", "soa_serial"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFreeipaDnsZoneDataSourceConfig = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-zoneds.example.test"
+  dnssec             = true
+  skip_overlap_check = true
+}
+
+data "freeipa_dns_zone" "test" {
+  name = freeipa_dns_zone.test.name
+}
+`
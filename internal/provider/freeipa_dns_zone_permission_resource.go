@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsZonePermissionResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsZonePermissionResource{}
+
+func NewFreeipaDnsZonePermissionResource() resource.Resource {
+	return &FreeipaDnsZonePermissionResource{}
+}
+
+// FreeipaDnsZonePermissionResource manages the permission of a DNS zone
+// through the raw JSON-RPC client, as go-freeipa cannot unmarshal zone
+// entries.
+type FreeipaDnsZonePermissionResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsZonePermissionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Zone           types.String `tfsdk:"zone"`
+	PermissionName types.String `tfsdk:"permission_name"`
+}
+
+func (r *FreeipaDnsZonePermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_permission"
+}
+
+func (r *FreeipaDnsZonePermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS zone permission resource. Creates the managed permission granting the management of a single DNS zone, to be added to a privilege",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS zone permission identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zone": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission generated by FreeIPA, such as `Manage DNS zone example.com.`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaDnsZonePermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// permissionNameFromDN returns the name of a permission from its DN, such as
+// `cn=Manage DNS zone example.com.,cn=permissions,cn=pbac,dc=example,dc=com`.
+func permissionNameFromDN(dn string) string {
+	rdn, _, _ := strings.Cut(dn, ",")
+	return strings.TrimPrefix(rdn, "cn=")
+}
+
+func (r *FreeipaDnsZonePermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsZonePermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var res rpcEntry
+	if err := r.rpc.call("dnszone_add_permission", []interface{}{data.Zone.ValueString()}, nil, &res); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create the permission of DNS zone %s, got error: %s", data.Zone.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created DNS zone permission: %s", data.Zone.ValueString()))
+
+	data.Id = data.Zone
+	data.PermissionName = types.StringPointerValue(res.value("value"))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsZonePermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsZonePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var res rpcEntryResult
+	err := r.rpc.call("dnszone_show", []interface{}{state.Zone.ValueString()}, map[string]interface{}{"all": true}, &res)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("DNS zone %s not found, removing its permission from the state", state.Zone.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone %s, got error: %s", state.Zone.String(), err))
+		return
+	}
+
+	// The zone is managed by its permission
+	managedBy := res.Result.value("managedby")
+	if managedBy == nil {
+		tflog.Warn(ctx, fmt.Sprintf("permission of DNS zone %s not found, removing it from the state", state.Zone.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = state.Zone
+	state.PermissionName = types.StringValue(permissionNameFromDN(*managedBy))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsZonePermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require a replacement, so there is nothing to update in place.
+	var plan FreeipaDnsZonePermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsZonePermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsZonePermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("dnszone_remove_permission", []interface{}{data.Zone.ValueString()}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the permission of DNS zone %s, got error: %s", data.Zone.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDnsZonePermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("zone"), req, resp)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsZonePermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsZonePermissionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_zone_permission.test", "id", "tftest-zoneperm.example.test"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone_permission.test", "permission_name", "Manage DNS zone tftest-zoneperm.example.test."),
+					resource.TestCheckTypeSetElemAttr("freeipa_privilege.test", "permissions.*", "Manage DNS zone tftest-zoneperm.example.test."),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_dns_zone_permission.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftest-zoneperm.example.test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsZonePermissionResourceConfig = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-zoneperm.example.test"
+  skip_overlap_check = true
+}
+
+resource "freeipa_dns_zone_permission" "test" {
+  zone = freeipa_dns_zone.test.name
+}
+
+resource "freeipa_privilege" "test" {
+  name        = "tftest-zoneperm"
+  permissions = [freeipa_dns_zone_permission.test.permission_name]
+}
+`
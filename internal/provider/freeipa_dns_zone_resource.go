@@ -0,0 +1,535 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaDnsZoneResource{}
+var _ resource.ResourceWithImportState = &FreeipaDnsZoneResource{}
+
+func NewFreeipaDnsZoneResource() resource.Resource {
+	return &FreeipaDnsZoneResource{}
+}
+
+// FreeipaDnsZoneResource manages DNS zones through the raw JSON-RPC client, as
+// go-freeipa cannot unmarshal zone entries.
+type FreeipaDnsZoneResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaDnsZoneResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	SoaMname         types.String `tfsdk:"soa_mname"`
+	SoaRname         types.String `tfsdk:"soa_rname"`
+	SoaSerial        types.Int64  `tfsdk:"soa_serial"`
+	SoaRefresh       types.Int64  `tfsdk:"soa_refresh"`
+	SoaRetry         types.Int64  `tfsdk:"soa_retry"`
+	SoaExpire        types.Int64  `tfsdk:"soa_expire"`
+	SoaMinimum       types.Int64  `tfsdk:"soa_minimum"`
+	Ttl              types.Int64  `tfsdk:"ttl"`
+	DynamicUpdate    types.Bool   `tfsdk:"dynamic_update"`
+	UpdatePolicy     types.String `tfsdk:"update_policy"`
+	AllowQuery       types.String `tfsdk:"allow_query"`
+	AllowTransfer    types.String `tfsdk:"allow_transfer"`
+	Forwarders       types.Set    `tfsdk:"forwarders"`
+	ForwardPolicy    types.String `tfsdk:"forward_policy"`
+	AllowSyncPtr     types.Bool   `tfsdk:"allow_sync_ptr"`
+	Dnssec           types.Bool   `tfsdk:"dnssec"`
+	Nsec3param       types.String `tfsdk:"nsec3param"`
+	SkipOverlapCheck types.Bool   `tfsdk:"skip_overlap_check"`
+	Enabled          types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *FreeipaDnsZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+// soaTimerAttribute returns the schema of an SOA timer, which FreeIPA
+// defaults when it is not set.
+func soaTimerAttribute(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *FreeipaDnsZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa DNS zone resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "DNS zone identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the zone, such as `example.com` or `2.0.192.in-addr.arpa`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"soa_mname": schema.StringAttribute{
+				MarkdownDescription: "Authoritative nameserver domain name of the SOA record. Defaults to the FreeIPA server",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"soa_rname": schema.StringAttribute{
+				MarkdownDescription: "Administrator e-mail address of the SOA record, in domain name form. Defaults to `hostmaster.<zone>`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"soa_serial": schema.Int64Attribute{
+				MarkdownDescription: "Serial number of the SOA record, managed by FreeIPA",
+				Computed:            true,
+			},
+			"soa_refresh": soaTimerAttribute("SOA record refresh time, in seconds"),
+			"soa_retry":   soaTimerAttribute("SOA record retry time, in seconds"),
+			"soa_expire":  soaTimerAttribute("SOA record expire time, in seconds"),
+			"soa_minimum": soaTimerAttribute("How long, in seconds, negative responses may be cached"),
+			"ttl": schema.Int64Attribute{
+				MarkdownDescription: "Time to live of the SOA record, in seconds",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"dynamic_update": schema.BoolAttribute{
+				MarkdownDescription: "Whether dynamic updates of the zone are allowed. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"update_policy": schema.StringAttribute{
+				MarkdownDescription: "BIND update policy of the zone. Defaults to letting hosts update their own records",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_query": schema.StringAttribute{
+				MarkdownDescription: "BIND access list of the clients allowed to query the zone, such as `any;` or `192.0.2.0/24;!192.0.2.1;`. Defaults to `any;`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_transfer": schema.StringAttribute{
+				MarkdownDescription: "BIND access list of the clients allowed to transfer the zone. Defaults to `none;`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"forwarders": schema.SetAttribute{
+				MarkdownDescription: "Per-zone forwarders, such as `192.0.2.53` or `192.0.2.53 port 5353`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"forward_policy": schema.StringAttribute{
+				MarkdownDescription: "Per-zone conditional forwarding policy, one of `first`, `only` or `none`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("first", "only", "none"),
+				},
+			},
+			"allow_sync_ptr": schema.BoolAttribute{
+				MarkdownDescription: "Whether A and AAAA record updates synchronize the matching PTR records. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"dnssec": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is signed with DNSSEC inline signing. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"nsec3param": schema.StringAttribute{
+				MarkdownDescription: "NSEC3PARAM record of the signed zone, such as `1 0 10 BEEF`. NSEC is used when unset",
+				Optional:            true,
+			},
+			"skip_overlap_check": schema.BoolAttribute{
+				MarkdownDescription: "Create the zone even if it overlaps with an existing zone",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the zone is active. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+func (r *FreeipaDnsZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// dnsNameEqual reports whether two domain names are the same, either of them
+// possibly being written without the final dot.
+func dnsNameEqual(a, b string) bool {
+	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+}
+
+// dnsNameValue converts a domain name returned by FreeIPA to a Terraform
+// string, keeping the prior spelling when it designates the same name.
+func dnsNameValue(prior types.String, name *string) types.String {
+	if name == nil {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && dnsNameEqual(prior.ValueString(), *name) {
+		return prior
+	}
+	return types.StringValue(*name)
+}
+
+// reversePointerName returns the fully qualified name of the PTR record of an
+// IP address, such as `1.2.0.192.in-addr.arpa.`.
+func reversePointerName(ip netip.Addr) string {
+	ip = ip.Unmap()
+	var labels []string
+	if ip.Is4() {
+		for _, b := range ip.As4() {
+			labels = append([]string{strconv.Itoa(int(b))}, labels...)
+		}
+		return strings.Join(labels, ".") + ".in-addr.arpa."
+	}
+	for _, b := range ip.As16() {
+		labels = append([]string{strconv.FormatUint(uint64(b&0xf), 16), strconv.FormatUint(uint64(b>>4), 16)}, labels...)
+	}
+	return strings.Join(labels, ".") + ".ip6.arpa."
+}
+
+// dnsZoneFor finds the most specific zone managed by FreeIPA holding a fully
+// qualified domain name. It returns the zone and the name relative to it, or
+// empty strings when no zone holds the name.
+func dnsZoneFor(rpc *rpcClient, fqdn string) (string, string, error) {
+	var res rpcFindResult
+	if err := rpc.call("dnszone_find", nil, map[string]interface{}{"pkey_only": true, "sizelimit": 0}, &res); err != nil {
+		return "", "", err
+	}
+
+	name := strings.ToLower(strings.TrimSuffix(fqdn, "."))
+	zone, relative := "", ""
+	for _, entry := range res.Result {
+		z := entry.value("idnsname")
+		if z == nil {
+			continue
+		}
+		suffix := strings.ToLower(strings.TrimSuffix(*z, "."))
+		if len(suffix) <= len(strings.TrimSuffix(zone, ".")) {
+			continue
+		}
+		if name == suffix {
+			zone, relative = *z, "@"
+		} else if strings.HasSuffix(name, "."+suffix) {
+			zone, relative = *z, name[:len(name)-len(suffix)-1]
+		}
+	}
+	return zone, relative, nil
+}
+
+// options returns the options of dnszone_add, or of dnszone_mod when state is
+// not nil.
+func (data *FreeipaDnsZoneResourceModel) options(ctx context.Context, state *FreeipaDnsZoneResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	options := map[string]interface{}{}
+
+	prior := state
+	if prior == nil {
+		prior = &FreeipaDnsZoneResourceModel{}
+	}
+	for _, o := range []struct {
+		name        string
+		plan, state attr.Value
+	}{
+		{"idnssoamname", data.SoaMname, prior.SoaMname},
+		{"idnssoarname", data.SoaRname, prior.SoaRname},
+		{"idnssoarefresh", data.SoaRefresh, prior.SoaRefresh},
+		{"idnssoaretry", data.SoaRetry, prior.SoaRetry},
+		{"idnssoaexpire", data.SoaExpire, prior.SoaExpire},
+		{"idnssoaminimum", data.SoaMinimum, prior.SoaMinimum},
+		{"dnsttl", data.Ttl, prior.Ttl},
+		{"idnsallowdynupdate", data.DynamicUpdate, prior.DynamicUpdate},
+		{"idnsupdatepolicy", data.UpdatePolicy, prior.UpdatePolicy},
+		{"idnsallowquery", data.AllowQuery, prior.AllowQuery},
+		{"idnsallowtransfer", data.AllowTransfer, prior.AllowTransfer},
+		{"idnsforwarders", data.Forwarders, prior.Forwarders},
+		{"idnsforwardpolicy", data.ForwardPolicy, prior.ForwardPolicy},
+		{"idnsallowsyncptr", data.AllowSyncPtr, prior.AllowSyncPtr},
+		{"idnssecinlinesigning", data.Dnssec, prior.Dnssec},
+		{"nsec3paramrecord", data.Nsec3param, prior.Nsec3param},
+	} {
+		if state == nil {
+			o.state = nil
+		}
+		diags.Append(setRPCOption(ctx, options, o.name, o.plan, o.state)...)
+	}
+
+	return options, diags
+}
+
+// setFromDnsZone copies the attributes of a FreeIPA DNS zone entry into the model.
+func (data *FreeipaDnsZoneResourceModel) setFromDnsZone(ctx context.Context, zone rpcEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringPointerValue(zone.value("idnsname"))
+	data.Name = dnsNameValue(data.Name, zone.value("idnsname"))
+	data.SoaMname = dnsNameValue(data.SoaMname, zone.value("idnssoamname"))
+	data.SoaRname = dnsNameValue(data.SoaRname, zone.value("idnssoarname"))
+	data.SoaSerial = types.Int64PointerValue(zone.int64Value("idnssoaserial"))
+	data.SoaRefresh = types.Int64PointerValue(zone.int64Value("idnssoarefresh"))
+	data.SoaRetry = types.Int64PointerValue(zone.int64Value("idnssoaretry"))
+	data.SoaExpire = types.Int64PointerValue(zone.int64Value("idnssoaexpire"))
+	data.SoaMinimum = types.Int64PointerValue(zone.int64Value("idnssoaminimum"))
+	data.Ttl = types.Int64PointerValue(zone.int64Value("dnsttl"))
+	data.UpdatePolicy = types.StringPointerValue(zone.value("idnsupdatepolicy"))
+	data.AllowQuery = types.StringPointerValue(zone.value("idnsallowquery"))
+	data.AllowTransfer = types.StringPointerValue(zone.value("idnsallowtransfer"))
+	forwarders := zone.values("idnsforwarders")
+	data.Forwarders, diags = membersSetValue(ctx, data.Forwarders, &forwarders)
+	data.ForwardPolicy = types.StringPointerValue(zone.value("idnsforwardpolicy"))
+	data.Nsec3param = types.StringPointerValue(zone.value("nsec3paramrecord"))
+
+	data.DynamicUpdate = types.BoolValue(false)
+	if v := zone.boolValue("idnsallowdynupdate"); v != nil {
+		data.DynamicUpdate = types.BoolValue(*v)
+	}
+	data.AllowSyncPtr = types.BoolValue(false)
+	if v := zone.boolValue("idnsallowsyncptr"); v != nil {
+		data.AllowSyncPtr = types.BoolValue(*v)
+	}
+	data.Dnssec = types.BoolValue(false)
+	if v := zone.boolValue("idnssecinlinesigning"); v != nil {
+		data.Dnssec = types.BoolValue(*v)
+	}
+	data.Enabled = types.BoolValue(true)
+	if v := zone.boolValue("idnszoneactive"); v != nil {
+		data.Enabled = types.BoolValue(*v)
+	}
+
+	return diags
+}
+
+// setEnabled enables or disables a DNS zone.
+func (r *FreeipaDnsZoneResource) setEnabled(name string, enabled bool) error {
+	if enabled {
+		err := r.rpc.call("dnszone_enable", []interface{}{name}, nil, nil)
+		if isFreeipaError(err, freeipaErrAlreadyActive) {
+			return nil
+		}
+		return err
+	}
+	err := r.rpc.call("dnszone_disable", []interface{}{name}, nil, nil)
+	if isFreeipaError(err, freeipaErrAlreadyInactive) {
+		return nil
+	}
+	return err
+}
+
+// showDnsZone retrieves a DNS zone entry.
+func (r *FreeipaDnsZoneResource) showDnsZone(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("dnszone_show", []interface{}{name}, map[string]interface{}{"all": true}, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaDnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaDnsZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := data.options(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if data.SkipOverlapCheck.ValueBool() {
+		options["skip_overlap_check"] = true
+	}
+
+	name := data.Name.ValueString()
+	if err := r.rpc.call("dnszone_add", []interface{}{name}, options, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DNS zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created DNS zone: %s", name))
+
+	if !data.Enabled.ValueBool() {
+		if err := r.setEnabled(name, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable DNS zone %s, got error: %s", data.Name.String(), err))
+			return
+		}
+	}
+
+	zone, err := r.showDnsZone(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromDnsZone(ctx, zone)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaDnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaDnsZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.showDnsZone(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("DNS zone %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromDnsZone(ctx, zone)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaDnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaDnsZoneResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options, diags := plan.options(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if len(options) > 0 {
+		err := r.rpc.call("dnszone_mod", []interface{}{name}, options, nil)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DNS zone %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := r.setEnabled(name, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change the state of DNS zone %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	zone, err := r.showDnsZone(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNS zone %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromDnsZone(ctx, zone)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaDnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaDnsZoneResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("dnszone_del", []interface{}{[]string{data.Name.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DNS zone %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaDnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaDnsZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaDnsZoneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "id", "tftest-zone.example.test."),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "soa_refresh", "1800"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "dynamic_update", "true"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "allow_query", "any;"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("freeipa_dns_zone.test", "soa_serial"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_dns_zone.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftest-zone.example.test",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				ImportStateVerifyIgnore:              []string{"soa_serial", "skip_overlap_check"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaDnsZoneResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "soa_refresh", "3600"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "ttl", "600"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "allow_transfer", "192.0.2.10;"),
+					resource.TestCheckTypeSetElemAttr("freeipa_dns_zone.test", "forwarders.*", "192.0.2.53"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "forward_policy", "only"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "dnssec", "true"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "nsec3param", "1 0 10 BEEF"),
+					resource.TestCheckResourceAttr("freeipa_dns_zone.test", "enabled", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaDnsZoneResourceConfig = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-zone.example.test"
+  soa_refresh        = 1800
+  dynamic_update     = true
+  skip_overlap_check = true
+}
+`
+
+const testAccFreeipaDnsZoneResourceConfigUpdate = `
+resource "freeipa_dns_zone" "test" {
+  name               = "tftest-zone.example.test"
+  soa_refresh        = 3600
+  ttl                = 600
+  allow_transfer     = "192.0.2.10;"
+  forwarders         = ["192.0.2.53"]
+  forward_policy     = "only"
+  dnssec             = true
+  nsec3param         = "1 0 10 BEEF"
+  skip_overlap_check = true
+  enabled            = false
+}
+`
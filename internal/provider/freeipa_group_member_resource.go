@@ -0,0 +1,237 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaGroupMemberResource{}
+var _ resource.ResourceWithImportState = &FreeipaGroupMemberResource{}
+
+func NewFreeipaGroupMemberResource() resource.Resource {
+	return &FreeipaGroupMemberResource{}
+}
+
+type FreeipaGroupMemberResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaGroupMemberResourceModel struct {
+	Id    types.String `tfsdk:"id"`
+	Group types.String `tfsdk:"group"`
+	Type  types.String `tfsdk:"type"`
+	Name  types.String `tfsdk:"name"`
+}
+
+// Member types of a group.
+const (
+	groupMemberTypeUser       = "user"
+	groupMemberTypeGroup      = "group"
+	groupMemberTypeExternal   = "external"
+	groupMemberTypeService    = "service"
+	groupMemberTypeIdoverride = "idoverride"
+)
+
+// groupMemberTypeOptions maps the member types of a group to the options of
+// the commands adding and removing them.
+var groupMemberTypeOptions = map[string]string{
+	groupMemberTypeUser:       "user",
+	groupMemberTypeGroup:      "group",
+	groupMemberTypeExternal:   "ipaexternalmember",
+	groupMemberTypeService:    "service",
+	groupMemberTypeIdoverride: "idoverrideuser",
+}
+
+func (r *FreeipaGroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_member"
+}
+
+func (r *FreeipaGroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa group member resource. Adds a single member to a group and leaves the other members alone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "group member identifier, in the `group/type/name` format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Name of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the member, one of `user`, `group`, `external`, `service` or `idoverride`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(groupMemberTypeUser, groupMemberTypeGroup, groupMemberTypeExternal, groupMemberTypeService, groupMemberTypeIdoverride),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the member: a user login, a group name, an external member, a service principal or a user ID override",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaGroupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// member returns the member declared in the model as a member list.
+func (data *FreeipaGroupMemberResourceModel) member() entryMembers {
+	return entryMembers{
+		groupMemberTypeOptions[data.Type.ValueString()]: {data.Name.ValueString()},
+	}
+}
+
+func (r *FreeipaGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaGroupMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := groupMemberCommands.addMembers(r.rpc, data.Group.ValueString(), data.member())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s %s to group %s, got error: %s", data.Type.ValueString(), data.Name.String(), data.Group.String(), err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{data.Group.ValueString(), data.Type.ValueString(), data.Name.ValueString()}, "/"))
+
+	tflog.Trace(ctx, fmt.Sprintf("created group member: %s", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaGroupMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := groupMemberCommands.showMembers(r.rpc, state.Group.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", state.Group.String(), err))
+		return
+	}
+
+	found := false
+	if err == nil {
+		name := state.Name.ValueString()
+		found = slices.ContainsFunc(members[groupMemberTypeOptions[state.Type.ValueString()]], func(member string) bool {
+			if state.Type.ValueString() == groupMemberTypeService {
+				return samePrincipal(member, name)
+			}
+			return member == name
+		})
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("group member %s not found, removing it from the state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require a replacement, so there is nothing to update in place.
+	var plan FreeipaGroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaGroupMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := groupMemberCommands.removeMembers(r.rpc, data.Group.ValueString(), data.member())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s %s from group %s, got error: %s", data.Type.ValueString(), data.Name.String(), data.Group.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// External members may contain slashes, so only split the group and type off
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: group/type/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaGroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaGroupMemberResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_group_member.test", "id", "tftestgroupmember/user/tftestmember"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "freeipa_group_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaGroupMemberResourceConfig = `
+resource "freeipa_user" "test" {
+  login      = "tftestmember"
+  first_name = "Terraform"
+  last_name  = "Member"
+}
+
+resource "freeipa_group" "test" {
+  name = "tftestgroupmember"
+}
+
+resource "freeipa_group_member" "test" {
+  group = freeipa_group.test.name
+  type  = "user"
+  name  = freeipa_user.test.login
+}
+`
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaGroupMembershipResource{}
+var _ resource.ResourceWithImportState = &FreeipaGroupMembershipResource{}
+
+func NewFreeipaGroupMembershipResource() resource.Resource {
+	return &FreeipaGroupMembershipResource{}
+}
+
+// FreeipaGroupMembershipResource manages group members through the raw
+// JSON-RPC client, as go-freeipa does not support service and ID override
+// members.
+type FreeipaGroupMembershipResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaGroupMembershipResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Group           types.String `tfsdk:"group"`
+	Users           types.Set    `tfsdk:"users"`
+	Groups          types.Set    `tfsdk:"groups"`
+	ExternalMembers types.Set    `tfsdk:"external_members"`
+	Services        types.Set    `tfsdk:"services"`
+	Idoverrides     types.Set    `tfsdk:"idoverrides"`
+}
+
+// groupMemberCommands manages the members of a group.
+var groupMemberCommands = memberCommands{
+	show:   "group_show",
+	add:    "group_add_member",
+	remove: "group_remove_member",
+	attributes: map[string]string{
+		"user":              "member_user",
+		"group":             "member_group",
+		"ipaexternalmember": "ipaexternalmember",
+		"service":           "member_service",
+		"idoverrideuser":    "member_idoverrideuser",
+	},
+}
+
+func (r *FreeipaGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *FreeipaGroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa group membership resource. Manages the complete member list of a group: members not declared here are removed from the group. Conflicts with `freeipa_group_member` for the same group",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "group membership identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				MarkdownDescription: "Name of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the member users",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the member groups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"external_members": schema.SetAttribute{
+				MarkdownDescription: "External members of an external group, such as Active Directory users and groups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"services": schema.SetAttribute{
+				MarkdownDescription: "Principals of the member services, such as `HTTP/www.example.com`. The realm may be omitted",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"idoverrides": schema.SetAttribute{
+				MarkdownDescription: "Member user ID overrides of the `Default Trust View`, such as `user@ad.example.com`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// memberSets returns the member sets of the model keyed by member type.
+func (data *FreeipaGroupMembershipResourceModel) memberSets() map[string]*types.Set {
+	return map[string]*types.Set{
+		"user":              &data.Users,
+		"group":             &data.Groups,
+		"ipaexternalmember": &data.ExternalMembers,
+		"service":           &data.Services,
+		"idoverrideuser":    &data.Idoverrides,
+	}
+}
+
+// setMembers stores the members of the group in the model. Service
+// principals keep the spelling of the model, with or without the realm.
+func (data *FreeipaGroupMembershipResourceModel) setMembers(ctx context.Context, members entryMembers) diag.Diagnostics {
+	known, diags := stringSetElements(ctx, data.Services)
+	members["service"] = matchPrincipals(members["service"], known)
+	diags.Append(setMembersSets(ctx, data.memberSets(), members)...)
+	return diags
+}
+
+// reconcile makes the members of the group match the model, then refreshes
+// the model from the group.
+func (r *FreeipaGroupMembershipResource) reconcile(ctx context.Context, data *FreeipaGroupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	group := data.Group.ValueString()
+
+	want, d := membersFromSets(ctx, data.memberSets())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	have, err := groupMemberCommands.showMembers(r.rpc, group)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", data.Group.String(), err))
+		return diags
+	}
+	have["service"] = matchPrincipals(have["service"], want["service"])
+
+	if err := groupMemberCommands.updateMembers(r.rpc, group, have, want); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update the members of group %s, got error: %s", data.Group.String(), err))
+		return diags
+	}
+
+	have, err = groupMemberCommands.showMembers(r.rpc, group)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", data.Group.String(), err))
+		return diags
+	}
+
+	data.Id = types.StringValue(group)
+	diags.Append(data.setMembers(ctx, have)...)
+	return diags
+}
+
+func (r *FreeipaGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created group membership: %s", data.Group.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := groupMemberCommands.showMembers(r.rpc, state.Group.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("group %s not found, removing its membership from the state", state.Group.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", state.Group.String(), err))
+		return
+	}
+
+	state.Id = types.StringValue(state.Group.ValueString())
+	resp.Diagnostics.Append(state.setMembers(ctx, members)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FreeipaGroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaGroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := membersFromSets(ctx, data.memberSets())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := groupMemberCommands.removeMembers(r.rpc, data.Group.ValueString(), members)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from group %s, got error: %s", data.Group.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("group"), req, resp)
+}
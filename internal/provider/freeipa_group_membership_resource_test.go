@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaGroupMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaGroupMembershipResourceConfig(`["tftestmember1", "tftestmember2"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_group_membership.test", "id", "tftestmembership"),
+					resource.TestCheckResourceAttr("freeipa_group_membership.test", "users.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_group_membership.test", "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_group_membership.test", "services.*", "HTTP/tftest-membership.corp.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_group_membership.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestmembership",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "group",
+				// Imported service principals include the realm
+				ImportStateVerifyIgnore: []string{"services"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaGroupMembershipResourceConfig(`["tftestmember2"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_group_membership.test", "users.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_group_membership.test", "users.*", "tftestmember2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaGroupMembershipResourceConfig(users string) string {
+	return `
+resource "freeipa_user" "member1" {
+  login      = "tftestmember1"
+  first_name = "Terraform"
+  last_name  = "Member1"
+}
+
+resource "freeipa_user" "member2" {
+  login      = "tftestmember2"
+  first_name = "Terraform"
+  last_name  = "Member2"
+}
+
+resource "freeipa_group" "nested" {
+  name = "tftestnested"
+}
+
+resource "freeipa_service" "member" {
+  principal       = "HTTP/tftest-membership.corp.example.com"
+  force           = true
+  skip_host_check = true
+}
+
+resource "freeipa_group" "test" {
+  name = "tftestmembership"
+}
+
+resource "freeipa_group_membership" "test" {
+  group    = freeipa_group.test.name
+  users    = ` + users + `
+  groups   = [freeipa_group.nested.name]
+  services = [freeipa_service.member.principal]
+
+  depends_on = [freeipa_user.member1, freeipa_user.member2]
+}
+`
+}
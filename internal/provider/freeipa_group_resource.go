@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaGroupResource{}
+var _ resource.ResourceWithImportState = &FreeipaGroupResource{}
+var _ resource.ResourceWithModifyPlan = &FreeipaGroupResource{}
+
+func NewFreeipaGroupResource() resource.Resource {
+	return &FreeipaGroupResource{}
+}
+
+type FreeipaGroupResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	GidNumber   types.Int64  `tfsdk:"gid_number"`
+	NonPosix    types.Bool   `tfsdk:"nonposix"`
+	External    types.Bool   `tfsdk:"external"`
+}
+
+func (r *FreeipaGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (r *FreeipaGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group",
+				Optional:            true,
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "GID of a POSIX group, assigned by the server if not provided",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"nonposix": schema.BoolAttribute{
+				MarkdownDescription: "Create a non-POSIX group. A non-POSIX group is converted to a POSIX group in place, the opposite requires a replacement. Defaults to `true` for external groups and `false` otherwise",
+				Optional:            true,
+				Computed:            true,
+			},
+			"external": schema.BoolAttribute{
+				MarkdownDescription: "Allow adding external non-IPA members, such as Active Directory users, to the group. A non-POSIX group is converted to an external group in place, the opposite requires a replacement. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *FreeipaGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FreeipaGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan FreeipaGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// External groups are always non-POSIX
+	if config.NonPosix.IsNull() && !plan.External.IsUnknown() {
+		plan.NonPosix = plan.External
+	}
+	if plan.External.ValueBool() && !plan.NonPosix.IsUnknown() && !plan.NonPosix.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("nonposix"), "Invalid group type", "External groups cannot be POSIX groups")
+		return
+	}
+	if plan.NonPosix.ValueBool() && !config.GidNumber.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("gid_number"), "Invalid group type", "Non-POSIX groups have no GID")
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		if plan.NonPosix.ValueBool() {
+			plan.GidNumber = types.Int64Null()
+		}
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	var state FreeipaGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// POSIX groups cannot be turned into non-POSIX groups, and external
+	// groups cannot be turned back into regular groups.
+	if !state.NonPosix.ValueBool() && plan.NonPosix.ValueBool() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("nonposix"))
+	}
+	if state.External.ValueBool() && !plan.External.ValueBool() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("external"))
+	}
+
+	switch {
+	case plan.NonPosix.ValueBool():
+		plan.GidNumber = types.Int64Null()
+	case state.NonPosix.ValueBool() && config.GidNumber.IsNull():
+		// The server assigns a GID when converting to a POSIX group
+		plan.GidNumber = types.Int64Unknown()
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.GroupAddOptionalArgs{
+		Nonposix: utils.RefBool(data.NonPosix.ValueBool()),
+		External: utils.RefBool(data.External.ValueBool()),
+	}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+	if !data.GidNumber.IsUnknown() && !data.GidNumber.IsNull() {
+		optArgs.Gidnumber = utils.RefInt(int(data.GidNumber.ValueInt64()))
+	}
+
+	_, err := r.client.GroupAdd(&freeipa.GroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created group: %s", data.Name.ValueString()))
+
+	if err := r.readGroup(&data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readGroup refreshes the model from the group entry stored in FreeIPA.
+func (r *FreeipaGroupResource) readGroup(data *FreeipaGroupResourceModel) error {
+	group, err := r.client.GroupShow(&freeipa.GroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}, &freeipa.GroupShowOptionalArgs{})
+	if err != nil {
+		return err
+	}
+
+	// The group entry does not expose its object classes, so look the group
+	// up among the external groups.
+	external, err := r.client.GroupFind("", &freeipa.GroupFindArgs{}, &freeipa.GroupFindOptionalArgs{
+		Cn:       utils.RefString(group.Result.Cn),
+		External: utils.RefBool(true),
+		PkeyOnly: utils.RefBool(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	data.Id = types.StringValue(group.Result.Cn)
+	data.Name = types.StringValue(group.Result.Cn)
+	data.Description = stringValueOrNull(group.Result.Description)
+	data.GidNumber = int64ValueOrNull(group.Result.Gidnumber)
+	data.NonPosix = types.BoolValue(group.Result.Gidnumber == nil)
+	data.External = types.BoolValue(external.Count > 0)
+	return nil
+}
+
+func (r *FreeipaGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readGroup(&state); err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("group %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.GroupModOptionalArgs{}
+	changed := false
+
+	if !plan.Description.Equal(state.Description) {
+		optArgs.Description = utils.RefString(plan.Description.ValueString())
+		changed = true
+	}
+	if state.NonPosix.ValueBool() && !plan.NonPosix.ValueBool() {
+		optArgs.Posix = utils.RefBool(true)
+		changed = true
+	}
+	if !plan.GidNumber.IsUnknown() && !plan.GidNumber.IsNull() && !plan.GidNumber.Equal(state.GidNumber) {
+		optArgs.Gidnumber = utils.RefInt(int(plan.GidNumber.ValueInt64()))
+		changed = true
+	}
+	if !state.External.ValueBool() && plan.External.ValueBool() {
+		optArgs.External = utils.RefBool(true)
+		changed = true
+	}
+
+	if changed {
+		_, err := r.client.GroupMod(&freeipa.GroupModArgs{
+			Cn: state.Name.ValueString(),
+		}, optArgs)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update group %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if err := r.readGroup(&plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GroupDel(&freeipa.GroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.GroupDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
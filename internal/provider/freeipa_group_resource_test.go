@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaGroupResourceConfig(true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_group.test", "id", "tftestgroup"),
+					resource.TestCheckResourceAttr("freeipa_group.test", "nonposix", "true"),
+					resource.TestCheckNoResourceAttr("freeipa_group.test", "gid_number"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_group.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestgroup",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update to a POSIX group in place
+			{
+				Config: testAccFreeipaGroupResourceConfig(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_group.test", "nonposix", "false"),
+					resource.TestCheckResourceAttrSet("freeipa_group.test", "gid_number"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaGroupResourceConfig(nonposix bool) string {
+	return fmt.Sprintf(`
+resource "freeipa_group" "test" {
+  name        = "tftestgroup"
+  description = "Terraform test group"
+  nonposix    = %[1]t
+}
+`, nonposix)
+}
@@ -0,0 +1,505 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHbacRuleResource{}
+var _ resource.ResourceWithImportState = &FreeipaHbacRuleResource{}
+
+func NewFreeipaHbacRuleResource() resource.Resource {
+	return &FreeipaHbacRuleResource{}
+}
+
+type FreeipaHbacRuleResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaHbacRuleResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	UserCategory    types.String `tfsdk:"usercategory"`
+	HostCategory    types.String `tfsdk:"hostcategory"`
+	ServiceCategory types.String `tfsdk:"servicecategory"`
+	Users           types.Set    `tfsdk:"users"`
+	Groups          types.Set    `tfsdk:"groups"`
+	Hosts           types.Set    `tfsdk:"hosts"`
+	Hostgroups      types.Set    `tfsdk:"hostgroups"`
+	Services        types.Set    `tfsdk:"services"`
+	ServiceGroups   types.Set    `tfsdk:"service_groups"`
+}
+
+// hbacRuleMembers holds the members of an HBAC rule by member type.
+type hbacRuleMembers struct {
+	users, groups, hosts, hostgroups, services, serviceGroups []string
+}
+
+func (r *FreeipaHbacRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_rule"
+}
+
+func (r *FreeipaHbacRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa HBAC rule resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HBAC rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the HBAC rule",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the HBAC rule is enabled. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the rule applies to, only `all` is supported. Conflicts with `users` and `groups`",
+				Optional:            true,
+				Validators:          categoryValidators("users", "groups"),
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the rule applies to, only `all` is supported. Conflicts with `hosts` and `hostgroups`",
+				Optional:            true,
+				Validators:          categoryValidators("hosts", "hostgroups"),
+			},
+			"servicecategory": schema.StringAttribute{
+				MarkdownDescription: "Service category the rule applies to, only `all` is supported. Conflicts with `services` and `service_groups`",
+				Optional:            true,
+				Validators:          categoryValidators("services", "service_groups"),
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the users the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the user groups the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Fqdns of the hosts the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hostgroups": schema.SetAttribute{
+				MarkdownDescription: "Names of the hostgroups the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"services": schema.SetAttribute{
+				MarkdownDescription: "Names of the HBAC services the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"service_groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the HBAC service groups the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaHbacRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// members returns the members declared in the model.
+func (data *FreeipaHbacRuleResourceModel) members(ctx context.Context) (*hbacRuleMembers, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	members := &hbacRuleMembers{}
+
+	members.users, d = stringSetElements(ctx, data.Users)
+	diags.Append(d...)
+	members.groups, d = stringSetElements(ctx, data.Groups)
+	diags.Append(d...)
+	members.hosts, d = stringSetElements(ctx, data.Hosts)
+	diags.Append(d...)
+	members.hostgroups, d = stringSetElements(ctx, data.Hostgroups)
+	diags.Append(d...)
+	members.services, d = stringSetElements(ctx, data.Services)
+	diags.Append(d...)
+	members.serviceGroups, d = stringSetElements(ctx, data.ServiceGroups)
+	diags.Append(d...)
+
+	return members, diags
+}
+
+// setFromHbacRule copies the attributes of a FreeIPA HBAC rule entry into the model.
+func (data *FreeipaHbacRuleResourceModel) setFromHbacRule(ctx context.Context, rule *freeipa.Hbacrule) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(rule.Cn)
+	data.Name = types.StringValue(rule.Cn)
+	data.Description = stringValueOrNull(rule.Description)
+	data.Enabled = types.BoolValue(rule.Ipaenabledflag == nil || *rule.Ipaenabledflag)
+	data.UserCategory = stringValueOrNull(rule.Usercategory)
+	data.HostCategory = stringValueOrNull(rule.Hostcategory)
+	data.ServiceCategory = stringValueOrNull(rule.Servicecategory)
+
+	data.Users, d = membersSetValue(ctx, data.Users, rule.MemberuserUser)
+	diags.Append(d...)
+	data.Groups, d = membersSetValue(ctx, data.Groups, rule.MemberuserGroup)
+	diags.Append(d...)
+	data.Hosts, d = membersSetValue(ctx, data.Hosts, rule.MemberhostHost)
+	diags.Append(d...)
+	data.Hostgroups, d = membersSetValue(ctx, data.Hostgroups, rule.MemberhostHostgroup)
+	diags.Append(d...)
+	data.Services, d = membersSetValue(ctx, data.Services, rule.MemberserviceHbacsvc)
+	diags.Append(d...)
+	data.ServiceGroups, d = membersSetValue(ctx, data.ServiceGroups, rule.MemberserviceHbacsvcgroup)
+	diags.Append(d...)
+
+	return diags
+}
+
+// addMembers adds users, hosts and services to an HBAC rule.
+func (r *FreeipaHbacRuleResource) addMembers(name string, members *hbacRuleMembers) error {
+	if len(members.users)+len(members.groups) > 0 {
+		res, err := r.client.HbacruleAddUser(&freeipa.HbacruleAddUserArgs{Cn: name}, &freeipa.HbacruleAddUserOptionalArgs{
+			User:  stringSliceOrNil(members.users),
+			Group: stringSliceOrNil(members.groups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	if len(members.hosts)+len(members.hostgroups) > 0 {
+		res, err := r.client.HbacruleAddHost(&freeipa.HbacruleAddHostArgs{Cn: name}, &freeipa.HbacruleAddHostOptionalArgs{
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	if len(members.services)+len(members.serviceGroups) > 0 {
+		res, err := r.client.HbacruleAddService(&freeipa.HbacruleAddServiceArgs{Cn: name}, &freeipa.HbacruleAddServiceOptionalArgs{
+			Hbacsvc:      stringSliceOrNil(members.services),
+			Hbacsvcgroup: stringSliceOrNil(members.serviceGroups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMembers removes users, hosts and services from an HBAC rule.
+func (r *FreeipaHbacRuleResource) removeMembers(name string, members *hbacRuleMembers) error {
+	if len(members.users)+len(members.groups) > 0 {
+		res, err := r.client.HbacruleRemoveUser(&freeipa.HbacruleRemoveUserArgs{Cn: name}, &freeipa.HbacruleRemoveUserOptionalArgs{
+			User:  stringSliceOrNil(members.users),
+			Group: stringSliceOrNil(members.groups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(members.hosts)+len(members.hostgroups) > 0 {
+		res, err := r.client.HbacruleRemoveHost(&freeipa.HbacruleRemoveHostArgs{Cn: name}, &freeipa.HbacruleRemoveHostOptionalArgs{
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(members.services)+len(members.serviceGroups) > 0 {
+		res, err := r.client.HbacruleRemoveService(&freeipa.HbacruleRemoveServiceArgs{Cn: name}, &freeipa.HbacruleRemoveServiceOptionalArgs{
+			Hbacsvc:      stringSliceOrNil(members.services),
+			Hbacsvcgroup: stringSliceOrNil(members.serviceGroups),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setEnabled enables or disables an HBAC rule.
+func (r *FreeipaHbacRuleResource) setEnabled(name string, enabled bool) error {
+	var err error
+	if enabled {
+		_, err = r.client.HbacruleEnable(&freeipa.HbacruleEnableArgs{Cn: name}, &freeipa.HbacruleEnableOptionalArgs{})
+		if isFreeipaError(err, freeipaErrAlreadyActive) {
+			return nil
+		}
+	} else {
+		_, err = r.client.HbacruleDisable(&freeipa.HbacruleDisableArgs{Cn: name}, &freeipa.HbacruleDisableOptionalArgs{})
+		if isFreeipaError(err, freeipaErrAlreadyInactive) {
+			return nil
+		}
+	}
+	return err
+}
+
+// showHbacRule retrieves an HBAC rule entry.
+func (r *FreeipaHbacRuleResource) showHbacRule(name string) (*freeipa.Hbacrule, error) {
+	rule, err := r.client.HbacruleShow(&freeipa.HbacruleShowArgs{Cn: name}, &freeipa.HbacruleShowOptionalArgs{})
+	if err != nil {
+		return nil, err
+	}
+	return &rule.Result, nil
+}
+
+func (r *FreeipaHbacRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHbacRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := data.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	optArgs := &freeipa.HbacruleAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = utils.RefString(data.UserCategory.ValueString())
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = utils.RefString(data.HostCategory.ValueString())
+	}
+	if !data.ServiceCategory.IsNull() {
+		optArgs.Servicecategory = utils.RefString(data.ServiceCategory.ValueString())
+	}
+
+	_, err := r.client.HbacruleAdd(&freeipa.HbacruleAddArgs{Cn: name}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HBAC rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created HBAC rule: %s", name))
+
+	if !data.Enabled.ValueBool() {
+		if err := r.setEnabled(name, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable HBAC rule %s, got error: %s", data.Name.String(), err))
+			return
+		}
+	}
+
+	if err := r.addMembers(name, members); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to HBAC rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showHbacRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromHbacRule(ctx, rule)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaHbacRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHbacRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.showHbacRule(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("HBAC rule %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromHbacRule(ctx, rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHbacRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaHbacRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	have, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	add, remove := &hbacRuleMembers{}, &hbacRuleMembers{}
+	add.users, remove.users = utils.Diff(have.users, want.users)
+	add.groups, remove.groups = utils.Diff(have.groups, want.groups)
+	add.hosts, remove.hosts = utils.Diff(have.hosts, want.hosts)
+	add.hostgroups, remove.hostgroups = utils.Diff(have.hostgroups, want.hostgroups)
+	add.services, remove.services = utils.Diff(have.services, want.services)
+	add.serviceGroups, remove.serviceGroups = utils.Diff(have.serviceGroups, want.serviceGroups)
+
+	// Members are removed before a category is set and added after it is
+	// cleared, since FreeIPA refuses members alongside a category.
+	if err := r.removeMembers(name, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from HBAC rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	optArgs := &freeipa.HbacruleModOptionalArgs{}
+	changed := false
+	attrs := []struct {
+		plan, state types.String
+		arg         **string
+	}{
+		{plan.Description, state.Description, &optArgs.Description},
+		{plan.UserCategory, state.UserCategory, &optArgs.Usercategory},
+		{plan.HostCategory, state.HostCategory, &optArgs.Hostcategory},
+		{plan.ServiceCategory, state.ServiceCategory, &optArgs.Servicecategory},
+	}
+	for _, a := range attrs {
+		if !a.plan.Equal(a.state) {
+			*a.arg = utils.RefString(a.plan.ValueString())
+			changed = true
+		}
+	}
+	if changed {
+		_, err := r.client.HbacruleMod(&freeipa.HbacruleModArgs{Cn: name}, optArgs)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HBAC rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := r.setEnabled(name, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HBAC rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if err := r.addMembers(name, add); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to HBAC rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showHbacRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromHbacRule(ctx, rule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaHbacRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHbacRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.HbacruleDel(&freeipa.HbacruleDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.HbacruleDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HBAC rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHbacRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHbacRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHbacRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "id", "tftesthbacrule"),
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "servicecategory", "all"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hbac_rule.test", "groups.*", "tftesthbacgroup"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hbac_rule.test", "hostgroups.*", "tftesthbachostgroup"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hbac_rule.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthbacrule",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHbacRuleResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "enabled", "false"),
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "usercategory", "all"),
+					resource.TestCheckNoResourceAttr("freeipa_hbac_rule.test", "servicecategory"),
+					resource.TestCheckResourceAttr("freeipa_hbac_rule.test", "groups.#", "0"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hbac_rule.test", "services.*", "sshd"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaHbacRuleResourcePrerequisites = `
+resource "freeipa_group" "test" {
+  name = "tftesthbacgroup"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name = "tftesthbachostgroup"
+}
+`
+
+const testAccFreeipaHbacRuleResourceConfig = testAccFreeipaHbacRuleResourcePrerequisites + `
+resource "freeipa_hbac_rule" "test" {
+  name            = "tftesthbacrule"
+  description     = "Terraform acceptance test"
+  servicecategory = "all"
+  groups          = [freeipa_group.test.name]
+  hostgroups      = [freeipa_hostgroup.test.name]
+}
+`
+
+const testAccFreeipaHbacRuleResourceConfigUpdate = testAccFreeipaHbacRuleResourcePrerequisites + `
+resource "freeipa_hbac_rule" "test" {
+  name         = "tftesthbacrule"
+  description  = "Terraform acceptance test"
+  enabled      = false
+  usercategory = "all"
+  hostgroups   = [freeipa_hostgroup.test.name]
+  services     = ["sshd"]
+}
+`
+
+func TestAccFreeipaHbacRuleResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// A category along with the members it replaces
+			{
+				Config:      testAccFreeipaHbacRuleResourceConfigCategoryConflict,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+const testAccFreeipaHbacRuleResourceConfigCategoryConflict = `
+resource "freeipa_hbac_rule" "test" {
+  name         = "tftesthbacconflict"
+  usercategory = "all"
+  users        = ["admin"]
+}
+`
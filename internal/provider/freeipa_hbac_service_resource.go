@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHbacServiceResource{}
+var _ resource.ResourceWithImportState = &FreeipaHbacServiceResource{}
+
+func NewFreeipaHbacServiceResource() resource.Resource {
+	return &FreeipaHbacServiceResource{}
+}
+
+type FreeipaHbacServiceResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaHbacServiceResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *FreeipaHbacServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_service"
+}
+
+func (r *FreeipaHbacServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa HBAC service resource. HBAC services are the PAM services HBAC rules grant access to",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HBAC service identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the HBAC service",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaHbacServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FreeipaHbacServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHbacServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.HbacsvcAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	service, err := r.client.HbacsvcAdd(&freeipa.HbacsvcAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HBAC service %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	data.setFromHbacService(&service.Result)
+
+	tflog.Trace(ctx, fmt.Sprintf("created HBAC service: %s", data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setFromHbacService copies the attributes of a FreeIPA HBAC service entry into the model.
+func (data *FreeipaHbacServiceResourceModel) setFromHbacService(service *freeipa.Hbacsvc) {
+	data.Id = types.StringValue(service.Cn)
+	data.Name = types.StringValue(service.Cn)
+	data.Description = stringValueOrNull(service.Description)
+}
+
+func (r *FreeipaHbacServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHbacServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.client.HbacsvcShow(&freeipa.HbacsvcShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.HbacsvcShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("HBAC service %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC service %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	state.setFromHbacService(&service.Result)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHbacServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaHbacServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		service, err := r.client.HbacsvcMod(&freeipa.HbacsvcModArgs{
+			Cn: state.Name.ValueString(),
+		}, &freeipa.HbacsvcModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HBAC service %s, got error: %s", state.Name.String(), err))
+			return
+		}
+		state.setFromHbacService(&service.Result)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHbacServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHbacServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.HbacsvcDel(&freeipa.HbacsvcDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.HbacsvcDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HBAC service %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHbacServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHbacServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHbacServiceResourceConfig("Terraform acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.test", "id", "tftesthbacsvc"),
+					resource.TestCheckResourceAttr("freeipa_hbac_service.test", "description", "Terraform acceptance test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hbac_service.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthbacsvc",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHbacServiceResourceConfig("Updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_service.test", "description", "Updated description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaHbacServiceResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "freeipa_hbac_service" "test" {
+  name        = "tftesthbacsvc"
+  description = %[1]q
+}
+`, description)
+}
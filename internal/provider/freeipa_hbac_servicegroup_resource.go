@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHbacServicegroupResource{}
+var _ resource.ResourceWithImportState = &FreeipaHbacServicegroupResource{}
+
+func NewFreeipaHbacServicegroupResource() resource.Resource {
+	return &FreeipaHbacServicegroupResource{}
+}
+
+type FreeipaHbacServicegroupResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaHbacServicegroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Services    types.Set    `tfsdk:"services"`
+}
+
+func (r *FreeipaHbacServicegroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_servicegroup"
+}
+
+func (r *FreeipaHbacServicegroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa HBAC service group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "HBAC service group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the HBAC service group",
+				Optional:            true,
+			},
+			"services": schema.SetAttribute{
+				MarkdownDescription: "Names of the member HBAC services. Services not declared here are removed from the group",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaHbacServicegroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromHbacServicegroup copies the attributes of a FreeIPA HBAC service group entry into the model.
+func (data *FreeipaHbacServicegroupResourceModel) setFromHbacServicegroup(ctx context.Context, group *freeipa.Hbacsvcgroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(group.Cn)
+	data.Name = types.StringValue(group.Cn)
+	data.Description = stringValueOrNull(group.Description)
+	data.Services, diags = membersSetValue(ctx, data.Services, group.MemberHbacsvc)
+
+	return diags
+}
+
+// updateServices adds and removes member services of an HBAC service group.
+func (r *FreeipaHbacServicegroupResource) updateServices(name string, add, remove []string) error {
+	if len(remove) > 0 {
+		res, err := r.client.HbacsvcgroupRemoveMember(&freeipa.HbacsvcgroupRemoveMemberArgs{
+			Cn: name,
+		}, &freeipa.HbacsvcgroupRemoveMemberOptionalArgs{
+			Hbacsvc: &remove,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		res, err := r.client.HbacsvcgroupAddMember(&freeipa.HbacsvcgroupAddMemberArgs{
+			Cn: name,
+		}, &freeipa.HbacsvcgroupAddMemberOptionalArgs{
+			Hbacsvc: &add,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *FreeipaHbacServicegroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHbacServicegroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	services, diags := stringSetElements(ctx, data.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.HbacsvcgroupAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	_, err := r.client.HbacsvcgroupAdd(&freeipa.HbacsvcgroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HBAC service group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created HBAC service group: %s", data.Name.ValueString()))
+
+	if err := r.updateServices(data.Name.ValueString(), services, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add services to HBAC service group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	group, err := r.client.HbacsvcgroupShow(&freeipa.HbacsvcgroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}, &freeipa.HbacsvcgroupShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC service group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromHbacServicegroup(ctx, &group.Result)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaHbacServicegroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHbacServicegroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.HbacsvcgroupShow(&freeipa.HbacsvcgroupShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.HbacsvcgroupShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("HBAC service group %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC service group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromHbacServicegroup(ctx, &group.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHbacServicegroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaHbacServicegroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := stringSetElements(ctx, plan.Services)
+	resp.Diagnostics.Append(diags...)
+	have, diags := stringSetElements(ctx, state.Services)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		_, err := r.client.HbacsvcgroupMod(&freeipa.HbacsvcgroupModArgs{
+			Cn: state.Name.ValueString(),
+		}, &freeipa.HbacsvcgroupModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HBAC service group %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	add, remove := utils.Diff(have, want)
+	if err := r.updateServices(state.Name.ValueString(), add, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update services of HBAC service group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	group, err := r.client.HbacsvcgroupShow(&freeipa.HbacsvcgroupShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.HbacsvcgroupShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC service group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromHbacServicegroup(ctx, &group.Result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaHbacServicegroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHbacServicegroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.HbacsvcgroupDel(&freeipa.HbacsvcgroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.HbacsvcgroupDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HBAC service group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHbacServicegroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
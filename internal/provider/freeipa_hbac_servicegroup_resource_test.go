@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHbacServicegroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHbacServicegroupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.test", "id", "tftesthbacsvcgroup"),
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.test", "services.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hbac_servicegroup.test", "services.*", "tftesthbacsvcmember"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hbac_servicegroup.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthbacsvcgroup",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHbacServicegroupResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hbac_servicegroup.test", "services.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hbac_servicegroup.test", "services.*", "sshd"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaHbacServicegroupResourceConfig = `
+resource "freeipa_hbac_service" "test" {
+  name = "tftesthbacsvcmember"
+}
+
+resource "freeipa_hbac_servicegroup" "test" {
+  name        = "tftesthbacsvcgroup"
+  description = "Terraform acceptance test"
+  services    = ["sshd", freeipa_hbac_service.test.name]
+}
+`
+
+const testAccFreeipaHbacServicegroupResourceConfigUpdate = `
+resource "freeipa_hbac_service" "test" {
+  name = "tftesthbacsvcmember"
+}
+
+resource "freeipa_hbac_servicegroup" "test" {
+  name        = "tftesthbacsvcgroup"
+  description = "Terraform acceptance test"
+  services    = ["sshd"]
+}
+`
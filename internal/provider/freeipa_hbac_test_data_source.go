@@ -0,0 +1,196 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &FreeipaHbacTestDataSource{}
+var _ datasource.DataSourceWithConfigure = &FreeipaHbacTestDataSource{}
+
+func NewFreeipaHbacTestDataSource() datasource.DataSource {
+	return &FreeipaHbacTestDataSource{}
+}
+
+type FreeipaHbacTestDataSource struct {
+	client *freeipa.Client
+}
+
+type FreeipaHbacTestDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	User            types.String `tfsdk:"user"`
+	Targethost      types.String `tfsdk:"targethost"`
+	Service         types.String `tfsdk:"service"`
+	Rules           types.Set    `tfsdk:"rules"`
+	Nodetail        types.Bool   `tfsdk:"nodetail"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	Disabled        types.Bool   `tfsdk:"disabled"`
+	AccessGranted   types.Bool   `tfsdk:"access_granted"`
+	MatchedRules    types.Set    `tfsdk:"matched_rules"`
+	NotMatchedRules types.Set    `tfsdk:"not_matched_rules"`
+}
+
+func (d *FreeipaHbacTestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hbac_test"
+}
+
+func (d *FreeipaHbacTestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa HBAC test data source. Simulates an access attempt against the HBAC rules",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the simulation, in the `user/targethost/service` format",
+				Computed:            true,
+			},
+			"user": schema.StringAttribute{
+				MarkdownDescription: "Login of the user attempting the access",
+				Required:            true,
+			},
+			"targethost": schema.StringAttribute{
+				MarkdownDescription: "Fqdn of the host being accessed",
+				Required:            true,
+			},
+			"service": schema.StringAttribute{
+				MarkdownDescription: "Name of the HBAC service used for the access",
+				Required:            true,
+			},
+			"rules": schema.SetAttribute{
+				MarkdownDescription: "Names of the HBAC rules to test. When not set, the enabled rules are tested",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"nodetail": schema.BoolAttribute{
+				MarkdownDescription: "Do not report which rules matched. `matched_rules` and `not_matched_rules` are then empty",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Include all the enabled rules in the test",
+				Optional:            true,
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Include all the disabled rules in the test",
+				Optional:            true,
+			},
+			"access_granted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the access is granted",
+				Computed:            true,
+			},
+			"matched_rules": schema.SetAttribute{
+				MarkdownDescription: "Names of the rules granting the access",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"not_matched_rules": schema.SetAttribute{
+				MarkdownDescription: "Names of the tested rules not granting the access",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *FreeipaHbacTestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = data.client
+}
+
+// hbacTestRules converts a list of rule names returned by hbactest into a set.
+func hbacTestRules(ctx context.Context, rules *[]interface{}) (types.Set, error) {
+	names := []string{}
+	if rules != nil {
+		for _, rule := range *rules {
+			name, ok := rule.(string)
+			if !ok {
+				return types.SetNull(types.StringType), fmt.Errorf("unexpected rule %v in hbactest result", rule)
+			}
+			names = append(names, name)
+		}
+	}
+	set, diags := types.SetValueFrom(ctx, types.StringType, names)
+	if diags.HasError() {
+		return set, fmt.Errorf("unable to convert the rules %v", names)
+	}
+	return set, nil
+}
+
+func (d *FreeipaHbacTestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data FreeipaHbacTestDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := stringSetElements(ctx, data.Rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.HbactestOptionalArgs{
+		Rules: stringSliceOrNil(rules),
+	}
+	if !data.Nodetail.IsNull() {
+		optArgs.Nodetail = data.Nodetail.ValueBoolPointer()
+	}
+	if !data.Enabled.IsNull() {
+		optArgs.Enabled = data.Enabled.ValueBoolPointer()
+	}
+	if !data.Disabled.IsNull() {
+		optArgs.Disabled = data.Disabled.ValueBoolPointer()
+	}
+
+	res, err := d.client.Hbactest(&freeipa.HbactestArgs{
+		User:       data.User.ValueString(),
+		Targethost: data.Targethost.ValueString(),
+		Service:    data.Service.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to test HBAC access of %s to %s with %s, got error: %s", data.User.String(), data.Targethost.String(), data.Service.String(), err))
+		return
+	}
+	if res.Error != nil && len(*res.Error) > 0 {
+		resp.Diagnostics.AddWarning("Invalid HBAC rules", fmt.Sprintf("The following rules do not exist or are invalid: %v", *res.Error))
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{data.User.ValueString(), data.Targethost.ValueString(), data.Service.ValueString()}, "/"))
+	data.AccessGranted = types.BoolValue(res.Value)
+	data.MatchedRules, err = hbacTestRules(ctx, res.Matched)
+	if err == nil {
+		data.NotMatchedRules, err = hbacTestRules(ctx, res.Notmatched)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HBAC test result, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHbacTestDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccFreeipaHbacTestDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.test", "id", "admin/tftest-hbactest.corp.example.com/sshd"),
+					resource.TestCheckResourceAttr("data.freeipa_hbac_test.test", "access_granted", "true"),
+					resource.TestCheckTypeSetElemAttr("data.freeipa_hbac_test.test", "matched_rules.*", "tftesthbactest"),
+				),
+			},
+		},
+	})
+}
+
+const testAccFreeipaHbacTestDataSourceConfig = `
+resource "freeipa_host" "test" {
+  fqdn = "tftest-hbactest.corp.example.com"
+}
+
+resource "freeipa_hbac_rule" "test" {
+  name     = "tftesthbactest"
+  users    = ["admin"]
+  hosts    = [freeipa_host.test.fqdn]
+  services = ["sshd"]
+}
+
+data "freeipa_hbac_test" "test" {
+  user       = "admin"
+  targethost = freeipa_host.test.fqdn
+  service    = "sshd"
+  rules      = [freeipa_hbac_rule.test.name]
+}
+`
@@ -9,15 +9,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"log"
+	"net/netip"
+	"strings"
 	"terraform-provider-freeipa/internal/utils"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &FreeipaHostResource{}
 var _ resource.ResourceWithImportState = &FreeipaHostResource{}
+var _ resource.ResourceWithModifyPlan = &FreeipaHostResource{}
 
 func NewFreeipaHostResource() resource.Resource {
 	return &FreeipaHostResource{}
@@ -25,14 +28,47 @@ func NewFreeipaHostResource() resource.Resource {
 
 type FreeipaHostResource struct {
 	client *freeipa.Client
+	rpc    *rpcClient
 }
 
 type FreeipaHostResourceModel struct {
-	Fqdn        types.String `tfsdk:"fqdn"`
-	Description types.String `tfsdk:"description"`
-	Force       types.Bool   `tfsdk:"force"`
-	NoReverse   types.Bool   `tfsdk:"noreverse"`
-	Id          types.String `tfsdk:"id"`
+	Fqdn         types.String `tfsdk:"fqdn"`
+	Description  types.String `tfsdk:"description"`
+	HostLocation types.String `tfsdk:"host_location"`
+	Force        types.Bool   `tfsdk:"force"`
+	NoReverse    types.Bool   `tfsdk:"noreverse"`
+	IpAddress    types.String `tfsdk:"ip_address"`
+	Id           types.String `tfsdk:"id"`
+
+	DnsZone              types.String `tfsdk:"dns_zone"`
+	DnsRecordName        types.String `tfsdk:"dns_record_name"`
+	ReverseDnsZone       types.String `tfsdk:"reverse_dns_zone"`
+	ReverseDnsRecordName types.String `tfsdk:"reverse_dns_record_name"`
+}
+
+// ipAddressValidator validates that a string is an IPv4 or IPv6 address.
+type ipAddressValidator struct{}
+
+func (v ipAddressValidator) Description(ctx context.Context) string {
+	return "value must be an IPv4 or IPv6 address"
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	ip, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err != nil || ip.Zone() != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+		)
+	}
 }
 
 func (r *FreeipaHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -55,6 +91,10 @@ func (r *FreeipaHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Description of the host",
 				Optional:            true,
 			},
+			"host_location": schema.StringAttribute{
+				MarkdownDescription: "Free-form physical location of the host, such as `Lab 2`. This is not an IPA location: IPA locations only apply to servers, see `freeipa_server_location`",
+				Optional:            true,
+			},
 			"force": schema.BoolAttribute{
 				MarkdownDescription: "Force the operation of host creation irrespective of the dns existence",
 				Optional:            true,
@@ -63,6 +103,29 @@ func (r *FreeipaHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "Do not create reverse DNS record",
 				Optional:            true,
 			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IPv4 or IPv6 address of the host. FreeIPA creates the matching A or AAAA record, and the PTR record unless `noreverse` is set",
+				Optional:            true,
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"dns_zone": schema.StringAttribute{
+				MarkdownDescription: "Zone of the A or AAAA record of `ip_address`",
+				Computed:            true,
+			},
+			"dns_record_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the A or AAAA record of `ip_address`, relative to `dns_zone`",
+				Computed:            true,
+			},
+			"reverse_dns_zone": schema.StringAttribute{
+				MarkdownDescription: "Zone of the PTR record of `ip_address`",
+				Computed:            true,
+			},
+			"reverse_dns_record_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the PTR record of `ip_address`, relative to `reverse_dns_zone`",
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "host identifier",
@@ -80,18 +143,185 @@ func (r *FreeipaHostResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*freeipa.Client)
+	data, ok := req.ProviderData.(*freeipaProviderData)
 
-	if !ok {
+	if !ok || data == nil {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// addressRecordType returns the FreeIPA attribute of the address records of ip.
+func addressRecordType(ip netip.Addr) string {
+	if ip.Unmap().Is4() {
+		return "arecord"
+	}
+	return "aaaarecord"
+}
+
+// setDnsRecords looks up the zones and names of the DNS records of the host
+// address.
+func (r *FreeipaHostResource) setDnsRecords(data *FreeipaHostResourceModel) error {
+	data.DnsZone, data.DnsRecordName = types.StringNull(), types.StringNull()
+	data.ReverseDnsZone, data.ReverseDnsRecordName = types.StringNull(), types.StringNull()
+	if data.IpAddress.IsNull() {
+		return nil
+	}
+	ip, err := netip.ParseAddr(data.IpAddress.ValueString())
+	if err != nil {
+		return err
+	}
+
+	zone, name, err := dnsZoneFor(r.rpc, data.Fqdn.ValueString())
+	if err != nil {
+		return err
+	}
+	if zone != "" {
+		data.DnsZone, data.DnsRecordName = types.StringValue(zone), types.StringValue(name)
+	}
+	if data.NoReverse.ValueBool() {
+		return nil
+	}
+	zone, name, err = dnsZoneFor(r.rpc, reversePointerName(ip))
+	if err != nil {
+		return err
+	}
+	if zone != "" {
+		data.ReverseDnsZone, data.ReverseDnsRecordName = types.StringValue(zone), types.StringValue(name)
+	}
+	return nil
+}
+
+// addDnsRecords creates the DNS records of the host address.
+func (r *FreeipaHostResource) addDnsRecords(data *FreeipaHostResourceModel) error {
+	ip, err := netip.ParseAddr(data.IpAddress.ValueString())
+	if err != nil {
+		return err
+	}
+	zone, name, err := dnsZoneFor(r.rpc, data.Fqdn.ValueString())
+	if err != nil {
+		return err
+	}
+	if zone == "" {
+		return fmt.Errorf("no DNS zone holds %s", data.Fqdn.ValueString())
+	}
+	recordType := addressRecordType(ip)
+	return r.rpc.call("dnsrecord_add", []interface{}{zone, name}, map[string]interface{}{
+		recordType: []string{ip.String()},
+		strings.TrimSuffix(recordType, "record") + "_extra_create_reverse": !data.NoReverse.ValueBool(),
+	}, nil)
+}
+
+// removeDnsRecords removes the DNS records of the host address, leaving the
+// other records of the names alone.
+func (r *FreeipaHostResource) removeDnsRecords(data *FreeipaHostResourceModel) error {
+	if data.IpAddress.IsNull() {
+		return nil
+	}
+	ip, err := netip.ParseAddr(data.IpAddress.ValueString())
+	if err != nil {
+		return err
+	}
+	if !data.DnsZone.IsNull() {
+		err := r.rpc.call("dnsrecord_del", []interface{}{data.DnsZone.ValueString(), data.DnsRecordName.ValueString()}, map[string]interface{}{
+			addressRecordType(ip): []string{ip.String()},
+		}, nil)
+		if err != nil && !isNotFound(err) && !isFreeipaError(err, freeipaErrAttrValueNotFound) {
+			return err
+		}
+	}
+	return r.removeReverseDnsRecord(data)
+}
+
+// addReverseDnsRecord creates the PTR record of the host address.
+func (r *FreeipaHostResource) addReverseDnsRecord(data *FreeipaHostResourceModel) error {
+	ip, err := netip.ParseAddr(data.IpAddress.ValueString())
+	if err != nil {
+		return err
+	}
+	zone, name, err := dnsZoneFor(r.rpc, reversePointerName(ip))
+	if err != nil {
+		return err
+	}
+	if zone == "" {
+		return fmt.Errorf("no reverse DNS zone holds %s", ip)
+	}
+	err = r.rpc.call("dnsrecord_add", []interface{}{zone, name}, map[string]interface{}{
+		"ptrrecord": []string{strings.TrimSuffix(data.Fqdn.ValueString(), ".") + "."},
+	}, nil)
+	if isFreeipaError(err, freeipaErrEmptyModlist) {
+		return nil
+	}
+	return err
+}
+
+// removeReverseDnsRecord removes the PTR record of the host address, leaving
+// the other records of the name alone.
+func (r *FreeipaHostResource) removeReverseDnsRecord(data *FreeipaHostResourceModel) error {
+	if data.ReverseDnsZone.IsNull() {
+		return nil
+	}
+	err := r.rpc.call("dnsrecord_del", []interface{}{data.ReverseDnsZone.ValueString(), data.ReverseDnsRecordName.ValueString()}, map[string]interface{}{
+		"ptrrecord": []string{strings.TrimSuffix(data.Fqdn.ValueString(), ".") + "."},
+	}, nil)
+	if err != nil && !isNotFound(err) && !isFreeipaError(err, freeipaErrAttrValueNotFound) {
+		return err
+	}
+	return nil
+}
+
+// hasAddressRecord reports whether the A or AAAA record of the host still
+// holds its address.
+func (r *FreeipaHostResource) hasAddressRecord(data *FreeipaHostResourceModel) (bool, error) {
+	ip, err := netip.ParseAddr(data.IpAddress.ValueString())
+	if err != nil {
+		return false, err
+	}
+	var res rpcEntryResult
+	err = r.rpc.call("dnsrecord_show", []interface{}{data.DnsZone.ValueString(), data.DnsRecordName.ValueString()}, nil, &res)
+	if isNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, value := range res.Result.values(addressRecordType(ip)) {
+		if other, err := netip.ParseAddr(value); err == nil && other == ip {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *FreeipaHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state FreeipaHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The DNS records only change along with the address, and the reverse
+	// record also along with noreverse
+	if !plan.Fqdn.Equal(state.Fqdn) || !plan.IpAddress.Equal(state.IpAddress) {
+		return
+	}
+	plan.DnsZone, plan.DnsRecordName = state.DnsZone, state.DnsRecordName
+	if !plan.NoReverse.IsUnknown() && plan.NoReverse.ValueBool() == state.NoReverse.ValueBool() {
+		plan.ReverseDnsZone, plan.ReverseDnsRecordName = state.ReverseDnsZone, state.ReverseDnsRecordName
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
 }
 
 func (r *FreeipaHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -120,15 +350,21 @@ func (r *FreeipaHostResource) Create(ctx context.Context, req resource.CreateReq
 	host, err := r.client.HostAdd(&freeipa.HostAddArgs{
 		Fqdn: data.Fqdn.ValueString(),
 	}, &freeipa.HostAddOptionalArgs{
-		Description: utils.RefString(data.Description.ValueString()),
-		Force:       utils.RefBool(data.Force.ValueBool()),
-		NoReverse:   utils.RefBool(data.NoReverse.ValueBool()),
+		Description:    utils.RefString(data.Description.ValueString()),
+		Nshostlocation: data.HostLocation.ValueStringPointer(),
+		Force:          utils.RefBool(data.Force.ValueBool()),
+		NoReverse:      utils.RefBool(data.NoReverse.ValueBool()),
+		IPAddress:      data.IpAddress.ValueStringPointer(),
 	})
 	if err != nil {
-		log.Fatal(err)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create host %s, got error: %s", data.Fqdn.String(), err))
+		return
 	}
 
 	data.Id = types.StringValue(host.Result.Fqdn)
+	if err := r.setDnsRecords(&data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the DNS records of %s, got error: %s", data.Fqdn.String(), err))
+	}
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -160,6 +396,22 @@ func (r *FreeipaHostResource) Read(ctx context.Context, req resource.ReadRequest
 	state.Id = types.StringValue(host.Result.Fqdn)
 	state.Fqdn = types.StringValue(host.Result.Fqdn)
 	state.Description = types.StringValue(*host.Result.Description)
+	state.HostLocation = types.StringPointerValue(host.Result.Nshostlocation)
+
+	// Forget the address when its record was removed so that it gets recreated
+	if !state.IpAddress.IsNull() && !state.DnsZone.IsNull() {
+		found, err := r.hasAddressRecord(&state)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the DNS records of %s, got error: %s", state.Fqdn.String(), err))
+			return
+		}
+		if !found {
+			tflog.Warn(ctx, fmt.Sprintf("address record of %s not found, removing ip_address from the state", state.Fqdn.ValueString()))
+			state.IpAddress = types.StringNull()
+			state.DnsZone, state.DnsRecordName = types.StringNull(), types.StringNull()
+			state.ReverseDnsZone, state.ReverseDnsRecordName = types.StringNull(), types.StringNull()
+		}
+	}
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
@@ -189,10 +441,68 @@ func (r *FreeipaHostResource) Update(ctx context.Context, req resource.UpdateReq
 		state.Description = types.StringValue(*host.Result.Description)
 	}
 
+	if !plan.HostLocation.Equal(state.HostLocation) {
+		optArgs := &freeipa.HostModOptionalArgs{}
+		if plan.HostLocation.IsNull() {
+			// An empty value removes the attribute
+			optArgs.Nshostlocation = utils.RefString("")
+		} else {
+			optArgs.Nshostlocation = plan.HostLocation.ValueStringPointer()
+		}
+		host, err := r.client.HostMod(
+			&freeipa.HostModArgs{
+				Fqdn: state.Fqdn.ValueString(),
+			}, optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the location of %s, got error: %s", plan.Fqdn.String(), err))
+			return
+		}
+		state.HostLocation = types.StringPointerValue(host.Result.Nshostlocation)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	if !plan.IpAddress.Equal(state.IpAddress) {
+		if err := r.removeDnsRecords(&state); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the DNS records of %s, got error: %s", state.Fqdn.String(), err))
+			return
+		}
+		state.IpAddress = plan.IpAddress
+		state.NoReverse = plan.NoReverse
+		if !state.IpAddress.IsNull() {
+			if err := r.addDnsRecords(&state); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create the DNS records of %s, got error: %s", state.Fqdn.String(), err))
+				return
+			}
+		}
+		if err := r.setDnsRecords(&state); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the DNS records of %s, got error: %s", state.Fqdn.String(), err))
+			return
+		}
+	} else if !state.IpAddress.IsNull() && plan.NoReverse.ValueBool() != state.NoReverse.ValueBool() {
+		// Only the reverse record follows noreverse
+		var err error
+		if plan.NoReverse.ValueBool() {
+			err = r.removeReverseDnsRecord(&state)
+		} else {
+			err = r.addReverseDnsRecord(&state)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the reverse DNS record of %s, got error: %s", state.Fqdn.String(), err))
+			return
+		}
+		state.NoReverse = plan.NoReverse
+		if err := r.setDnsRecords(&state); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to look up the DNS records of %s, got error: %s", state.Fqdn.String(), err))
+			return
+		}
+	}
+
+	state.NoReverse = plan.NoReverse
+	state.Force = plan.Force
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
@@ -206,6 +516,11 @@ func (r *FreeipaHostResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	if err := r.removeDnsRecords(&data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the DNS records of %s, got error: %s", data.Fqdn.String(), err))
+		return
+	}
+
 	_, err := r.client.HostDel(
 		&freeipa.HostDelArgs{
 			Fqdn: []string{data.Fqdn.ValueString()},
@@ -33,3 +33,111 @@ resource "freeipa_host" "test" {
 }
 `, fqdn)
 }
+
+func TestAccFreeipaHostResource_ipAddress(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostResourceConfigIpAddress("192.0.2.10", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "ip_address", "192.0.2.10"),
+					resource.TestCheckResourceAttr("freeipa_host.test", "dns_zone", "tftest-host.example.test."),
+					resource.TestCheckResourceAttr("freeipa_host.test", "dns_record_name", "web"),
+					resource.TestCheckResourceAttr("freeipa_host.test", "reverse_dns_zone", "2.0.192.in-addr.arpa."),
+					resource.TestCheckResourceAttr("freeipa_host.test", "reverse_dns_record_name", "10"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHostResourceConfigIpAddress("192.0.2.20", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "ip_address", "192.0.2.20"),
+					resource.TestCheckResourceAttr("freeipa_host.test", "reverse_dns_record_name", "20"),
+				),
+			},
+			// Remove the reverse record only
+			{
+				Config: testAccFreeipaHostResourceConfigIpAddress("192.0.2.20", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "dns_record_name", "web"),
+					resource.TestCheckNoResourceAttr("freeipa_host.test", "reverse_dns_zone"),
+					resource.TestCheckNoResourceAttr("freeipa_host.test", "reverse_dns_record_name"),
+				),
+			},
+			// Recreate the reverse record
+			{
+				Config: testAccFreeipaHostResourceConfigIpAddress("192.0.2.20", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "reverse_dns_zone", "2.0.192.in-addr.arpa."),
+					resource.TestCheckResourceAttr("freeipa_host.test", "reverse_dns_record_name", "20"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaHostResourceConfigIpAddress(ip string, noreverse bool) string {
+	return fmt.Sprintf(`
+resource "freeipa_dns_zone" "forward" {
+  name               = "tftest-host.example.test"
+  skip_overlap_check = true
+}
+
+resource "freeipa_dns_zone" "reverse" {
+  name               = "2.0.192.in-addr.arpa"
+  skip_overlap_check = true
+}
+
+resource "freeipa_host" "test" {
+  fqdn       = "web.${freeipa_dns_zone.forward.name}"
+  ip_address = %[1]q
+  noreverse  = %[2]t
+
+  depends_on = [freeipa_dns_zone.reverse]
+}
+`, ip, noreverse)
+}
+
+func TestAccFreeipaHostResource_hostLocation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostResourceConfigHostLocation(`"Lab 2"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "host_location", "Lab 2"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHostResourceConfigHostLocation(`"Lab 3"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_host.test", "host_location", "Lab 3"),
+				),
+			},
+			// Remove the location
+			{
+				Config: testAccFreeipaHostResourceConfigHostLocation(`null`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_host.test", "host_location"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaHostResourceConfigHostLocation(location string) string {
+	return fmt.Sprintf(`
+resource "freeipa_host" "test" {
+  fqdn          = "tftest-hostlocation.corp.example.com"
+  host_location = %[1]s
+}
+`, location)
+}
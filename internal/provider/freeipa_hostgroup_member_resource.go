@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHostgroupMemberResource{}
+var _ resource.ResourceWithImportState = &FreeipaHostgroupMemberResource{}
+
+func NewFreeipaHostgroupMemberResource() resource.Resource {
+	return &FreeipaHostgroupMemberResource{}
+}
+
+type FreeipaHostgroupMemberResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaHostgroupMemberResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	Hostgroup types.String `tfsdk:"hostgroup"`
+	Type      types.String `tfsdk:"type"`
+	Name      types.String `tfsdk:"name"`
+}
+
+// Member types of a hostgroup.
+const (
+	hostgroupMemberTypeHost      = "host"
+	hostgroupMemberTypeHostgroup = "hostgroup"
+)
+
+func (r *FreeipaHostgroupMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hostgroup_member"
+}
+
+func (r *FreeipaHostgroupMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa group member resource. Adds a single member to a hostgroup and leaves the other members alone",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "hostgroup member identifier, in the `hostgroup/type/name` format",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Name of the hostgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the member, one of `host` or `hostgroup`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(hostgroupMemberTypeHost, hostgroupMemberTypeHostgroup),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the member: a host fqdn or a hostgroup name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaHostgroupMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// member returns the member declared in the model as a member list. The
+// member types are the options of the commands adding and removing them.
+func (data *FreeipaHostgroupMemberResourceModel) member() entryMembers {
+	return entryMembers{
+		data.Type.ValueString(): {data.Name.ValueString()},
+	}
+}
+
+func (r *FreeipaHostgroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHostgroupMemberResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := hostgroupMemberCommands.addMembers(r.rpc, data.Hostgroup.ValueString(), data.member())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add %s %s to hostgroup %s, got error: %s", data.Type.ValueString(), data.Name.String(), data.Hostgroup.String(), err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{data.Hostgroup.ValueString(), data.Type.ValueString(), data.Name.ValueString()}, "/"))
+
+	tflog.Trace(ctx, fmt.Sprintf("created hostgroup member: %s", data.Id.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaHostgroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHostgroupMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := hostgroupMemberCommands.showMembers(r.rpc, state.Hostgroup.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", state.Hostgroup.String(), err))
+		return
+	}
+
+	found := false
+	if err == nil {
+		found = slices.Contains(members[state.Type.ValueString()], state.Name.ValueString())
+	}
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("hostgroup member %s not found, removing it from the state", state.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHostgroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require a replacement, so there is nothing to update in place.
+	var plan FreeipaHostgroupMemberResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaHostgroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHostgroupMemberResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := hostgroupMemberCommands.removeMembers(r.rpc, data.Hostgroup.ValueString(), data.member())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove %s %s from hostgroup %s, got error: %s", data.Type.ValueString(), data.Name.String(), data.Hostgroup.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHostgroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: hostgroup/type/name. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("hostgroup"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHostgroupMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostgroupMemberResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hostgroup_member.test", "id", "tftesthgmember/host/tftest-hgmember.corp.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "freeipa_hostgroup_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaHostgroupMemberResourceConfig = `
+resource "freeipa_host" "test" {
+  fqdn = "tftest-hgmember.corp.example.com"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name = "tftesthgmember"
+}
+
+resource "freeipa_hostgroup_member" "test" {
+  hostgroup = freeipa_hostgroup.test.name
+  type      = "host"
+  name      = freeipa_host.test.fqdn
+}
+`
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHostgroupMembershipResource{}
+var _ resource.ResourceWithImportState = &FreeipaHostgroupMembershipResource{}
+
+func NewFreeipaHostgroupMembershipResource() resource.Resource {
+	return &FreeipaHostgroupMembershipResource{}
+}
+
+type FreeipaHostgroupMembershipResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaHostgroupMembershipResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Hostgroup  types.String `tfsdk:"hostgroup"`
+	Hosts      types.Set    `tfsdk:"hosts"`
+	Hostgroups types.Set    `tfsdk:"hostgroups"`
+}
+
+// hostgroupMemberCommands manages the members of a hostgroup.
+var hostgroupMemberCommands = memberCommands{
+	show:   "hostgroup_show",
+	add:    "hostgroup_add_member",
+	remove: "hostgroup_remove_member",
+	attributes: map[string]string{
+		"host":      "member_host",
+		"hostgroup": "member_hostgroup",
+	},
+}
+
+func (r *FreeipaHostgroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hostgroup_membership"
+}
+
+func (r *FreeipaHostgroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa hostgroup membership resource. Manages the complete member list of a hostgroup: members not declared here are removed from the hostgroup. Conflicts with `freeipa_hostgroup_member` for the same hostgroup",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "hostgroup membership identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostgroup": schema.StringAttribute{
+				MarkdownDescription: "Name of the hostgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Fqdns of the member hosts",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hostgroups": schema.SetAttribute{
+				MarkdownDescription: "Names of the nested member hostgroups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaHostgroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// memberSets returns the member sets of the model keyed by member type.
+func (data *FreeipaHostgroupMembershipResourceModel) memberSets() map[string]*types.Set {
+	return map[string]*types.Set{
+		"host":      &data.Hosts,
+		"hostgroup": &data.Hostgroups,
+	}
+}
+
+// reconcile makes the members of the hostgroup match the model, then
+// refreshes the model from the hostgroup.
+func (r *FreeipaHostgroupMembershipResource) reconcile(ctx context.Context, data *FreeipaHostgroupMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	hostgroup := data.Hostgroup.ValueString()
+
+	want, d := membersFromSets(ctx, data.memberSets())
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	have, err := hostgroupMemberCommands.showMembers(r.rpc, hostgroup)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", data.Hostgroup.String(), err))
+		return diags
+	}
+
+	if err := hostgroupMemberCommands.updateMembers(r.rpc, hostgroup, have, want); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update the members of hostgroup %s, got error: %s", data.Hostgroup.String(), err))
+		return diags
+	}
+
+	have, err = hostgroupMemberCommands.showMembers(r.rpc, hostgroup)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", data.Hostgroup.String(), err))
+		return diags
+	}
+
+	data.Id = types.StringValue(hostgroup)
+	diags.Append(setMembersSets(ctx, data.memberSets(), have)...)
+	return diags
+}
+
+func (r *FreeipaHostgroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHostgroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created hostgroup membership: %s", data.Hostgroup.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaHostgroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHostgroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := hostgroupMemberCommands.showMembers(r.rpc, state.Hostgroup.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("hostgroup %s not found, removing its membership from the state", state.Hostgroup.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", state.Hostgroup.String(), err))
+		return
+	}
+
+	state.Id = types.StringValue(state.Hostgroup.ValueString())
+	resp.Diagnostics.Append(setMembersSets(ctx, state.memberSets(), members)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHostgroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan FreeipaHostgroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaHostgroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHostgroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := membersFromSets(ctx, data.memberSets())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := hostgroupMemberCommands.removeMembers(r.rpc, data.Hostgroup.ValueString(), members)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from hostgroup %s, got error: %s", data.Hostgroup.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHostgroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("hostgroup"), req, resp)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHostgroupMembershipResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostgroupMembershipResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hostgroup_membership.test", "id", "tftesthgmembership"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hostgroup_membership.test", "hosts.*", "tftest-hgmember.corp.example.com"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hostgroup_membership.test", "hostgroups.*", "tftesthgnested"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hostgroup_membership.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthgmembership",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "hostgroup",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaHostgroupMembershipResourceConfig = `
+resource "freeipa_host" "test" {
+  fqdn = "tftest-hgmember.corp.example.com"
+}
+
+resource "freeipa_hostgroup" "nested" {
+  name = "tftesthgnested"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name = "tftesthgmembership"
+}
+
+resource "freeipa_hostgroup_membership" "test" {
+  hostgroup  = freeipa_hostgroup.test.name
+  hosts      = [freeipa_host.test.fqdn]
+  hostgroups = [freeipa_hostgroup.nested.name]
+}
+`
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaHostgroupResource{}
+var _ resource.ResourceWithImportState = &FreeipaHostgroupResource{}
+
+func NewFreeipaHostgroupResource() resource.Resource {
+	return &FreeipaHostgroupResource{}
+}
+
+type FreeipaHostgroupResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaHostgroupResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	MemberManagerUsers  types.Set    `tfsdk:"member_manager_users"`
+	MemberManagerGroups types.Set    `tfsdk:"member_manager_groups"`
+}
+
+// hostgroupMemberManagerCommands manages the users and groups allowed to
+// manage the members of a hostgroup.
+var hostgroupMemberManagerCommands = memberCommands{
+	show:   "hostgroup_show",
+	add:    "hostgroup_add_member_manager",
+	remove: "hostgroup_remove_member_manager",
+	attributes: map[string]string{
+		"user":  "membermanager_user",
+		"group": "membermanager_group",
+	},
+}
+
+func (r *FreeipaHostgroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hostgroup"
+}
+
+func (r *FreeipaHostgroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa hostgroup resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "hostgroup identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the hostgroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the hostgroup",
+				Optional:            true,
+			},
+			"member_manager_users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the users allowed to manage the members of the hostgroup",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"member_manager_groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the groups whose members are allowed to manage the members of the hostgroup",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaHostgroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+func (r *FreeipaHostgroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaHostgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.HostgroupAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	managers, diags := membersFromSets(ctx, data.memberManagerSets())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	_, err := r.client.HostgroupAdd(&freeipa.HostgroupAddArgs{
+		Cn: name,
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create hostgroup %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created hostgroup: %s", name))
+
+	if err := hostgroupMemberManagerCommands.addMembers(r.rpc, name, managers); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add member managers to hostgroup %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	hostgroup, err := r.showHostgroup(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromHostgroup(ctx, hostgroup)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// memberManagerSets returns the member manager sets of the model keyed by
+// member type.
+func (data *FreeipaHostgroupResourceModel) memberManagerSets() map[string]*types.Set {
+	return map[string]*types.Set{
+		"user":  &data.MemberManagerUsers,
+		"group": &data.MemberManagerGroups,
+	}
+}
+
+// setFromHostgroup copies the attributes of a FreeIPA hostgroup entry into the model.
+func (data *FreeipaHostgroupResourceModel) setFromHostgroup(ctx context.Context, hostgroup rpcEntry) diag.Diagnostics {
+	data.Id = types.StringPointerValue(hostgroup.value("cn"))
+	data.Name = types.StringPointerValue(hostgroup.value("cn"))
+	data.Description = types.StringPointerValue(hostgroup.value("description"))
+	return setMembersSets(ctx, data.memberManagerSets(), hostgroupMemberManagerCommands.membersOf(hostgroup))
+}
+
+// showHostgroup retrieves a hostgroup entry. go-freeipa does not return the
+// member managers of hostgroups, so the raw client is used.
+func (r *FreeipaHostgroupResource) showHostgroup(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("hostgroup_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaHostgroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaHostgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostgroup, err := r.showHostgroup(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("hostgroup %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromHostgroup(ctx, hostgroup)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaHostgroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaHostgroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if !plan.Description.Equal(state.Description) {
+		_, err := r.client.HostgroupMod(&freeipa.HostgroupModArgs{
+			Cn: name,
+		}, &freeipa.HostgroupModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hostgroup %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	want, diags := membersFromSets(ctx, plan.memberManagerSets())
+	resp.Diagnostics.Append(diags...)
+	have, diags := membersFromSets(ctx, state.memberManagerSets())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := hostgroupMemberManagerCommands.updateMembers(r.rpc, name, have, want); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the member managers of hostgroup %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	hostgroup, err := r.showHostgroup(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read hostgroup %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromHostgroup(ctx, hostgroup)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaHostgroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaHostgroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.HostgroupDel(&freeipa.HostgroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.HostgroupDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete hostgroup %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaHostgroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaHostgroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostgroupResourceConfig("one"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hostgroup.test", "id", "tftesthostgroup"),
+					resource.TestCheckResourceAttr("freeipa_hostgroup.test", "description", "one"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hostgroup.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthostgroup",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHostgroupResourceConfig("two"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hostgroup.test", "description", "two"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaHostgroupResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "freeipa_hostgroup" "test" {
+  name        = "tftesthostgroup"
+  description = %[1]q
+}
+`, description)
+}
+
+func TestAccFreeipaHostgroupResource_memberManagers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaHostgroupResourceConfigMemberManagers(`[freeipa_user.manager.login]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("freeipa_hostgroup.test", "member_manager_users.*", "tftesthgmanager"),
+					resource.TestCheckTypeSetElemAttr("freeipa_hostgroup.test", "member_manager_groups.*", "tftesthgmanagers"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_hostgroup.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftesthostgroupmanaged",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaHostgroupResourceConfigMemberManagers(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_hostgroup.test", "member_manager_users.#", "0"),
+					resource.TestCheckResourceAttr("freeipa_hostgroup.test", "member_manager_groups.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaHostgroupResourceConfigMemberManagers(users string) string {
+	return `
+resource "freeipa_user" "manager" {
+  login      = "tftesthgmanager"
+  first_name = "Terraform"
+  last_name  = "Manager"
+}
+
+resource "freeipa_group" "managers" {
+  name = "tftesthgmanagers"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name                  = "tftesthostgroupmanaged"
+  member_manager_users  = ` + users + `
+  member_manager_groups = [freeipa_group.managers.name]
+
+  depends_on = [freeipa_user.manager]
+}
+`
+}
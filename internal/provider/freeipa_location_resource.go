@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaLocationResource{}
+var _ resource.ResourceWithImportState = &FreeipaLocationResource{}
+
+func NewFreeipaLocationResource() resource.Resource {
+	return &FreeipaLocationResource{}
+}
+
+// FreeipaLocationResource manages IPA locations through the raw JSON-RPC
+// client, as go-freeipa cannot unmarshal location entries.
+type FreeipaLocationResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaLocationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *FreeipaLocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_location"
+}
+
+func (r *FreeipaLocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa location resource. Clients in a location are directed to the IPA servers of that location first",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "location identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the location, a single DNS label such as `paris`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the location",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// setFromLocation copies the attributes of a FreeIPA location entry into the
+// model.
+func (data *FreeipaLocationResourceModel) setFromLocation(location rpcEntry) {
+	data.Id = types.StringPointerValue(location.value("idnsname"))
+	data.Name = dnsNameValue(data.Name, location.value("idnsname"))
+	data.Description = types.StringPointerValue(location.value("description"))
+}
+
+// showLocation retrieves a location entry.
+func (r *FreeipaLocationResource) showLocation(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("location_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "description", data.Description, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if err := r.rpc.call("location_add", []interface{}{name}, options, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create location %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created location: %s", name))
+
+	location, err := r.showLocation(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read location %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	data.setFromLocation(location)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	location, err := r.showLocation(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("location %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read location %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	state.setFromLocation(location)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "description", plan.Description, state.Description)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	if len(options) > 0 {
+		err := r.rpc.call("location_mod", []interface{}{name}, options, nil)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update location %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	location, err := r.showLocation(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read location %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	plan.setFromLocation(location)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("location_del", []interface{}{[]string{data.Name.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete location %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
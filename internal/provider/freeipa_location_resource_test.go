@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaLocationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaLocationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_location.test", "id", "tftest-location"),
+					resource.TestCheckResourceAttr("freeipa_location.test", "description", "Test datacenter"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_location.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftest-location",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaLocationResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("freeipa_location.test", "description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaLocationResourceConfig = `
+resource "freeipa_location" "test" {
+  name        = "tftest-location"
+  description = "Test datacenter"
+}
+`
+
+const testAccFreeipaLocationResourceConfigUpdate = `
+resource "freeipa_location" "test" {
+  name = "tftest-location"
+}
+`
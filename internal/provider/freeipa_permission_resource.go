@@ -0,0 +1,383 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaPermissionResource{}
+var _ resource.ResourceWithImportState = &FreeipaPermissionResource{}
+var _ resource.ResourceWithModifyPlan = &FreeipaPermissionResource{}
+
+func NewFreeipaPermissionResource() resource.Resource {
+	return &FreeipaPermissionResource{}
+}
+
+type FreeipaPermissionResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaPermissionResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Rights     types.Set    `tfsdk:"rights"`
+	Type       types.String `tfsdk:"type"`
+	Attributes types.Set    `tfsdk:"attributes"`
+	Filters    types.Set    `tfsdk:"filters"`
+	Subtree    types.String `tfsdk:"subtree"`
+	Memberof   types.Set    `tfsdk:"memberof"`
+	BindType   types.String `tfsdk:"bind_type"`
+}
+
+func (r *FreeipaPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *FreeipaPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa permission resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "permission identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the permission",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rights": schema.SetAttribute{
+				MarkdownDescription: "Rights granted by the permission, among `read`, `search`, `compare`, `write`, `add`, `delete` and `all`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("read", "search", "compare", "write", "add", "delete", "all"),
+					),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the entries the permission applies to, such as `user`, `group` or `host`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes the rights apply to. Attribute names are case-insensitive, their configured spelling is kept",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"filters": schema.SetAttribute{
+				MarkdownDescription: "Extra LDAP filters the target entries must match",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"subtree": schema.StringAttribute{
+				MarkdownDescription: "DN of the subtree the permission applies to. Derived from `type` when not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"memberof": schema.SetAttribute{
+				MarkdownDescription: "Names of the groups the target entries must be members of",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"bind_type": schema.StringAttribute{
+				MarkdownDescription: "Bind rule type of the permission, one of `permission`, `all`, `anonymous` or `self`. Defaults to `permission`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("permission"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("permission", "all", "anonymous", "self"),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromPermission copies the attributes of a FreeIPA permission entry into the model.
+func (data *FreeipaPermissionResourceModel) setFromPermission(ctx context.Context, permission *freeipa.Permission) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(permission.Cn)
+	data.Name = types.StringValue(permission.Cn)
+	data.Type = stringValueOrNull(permission.Type)
+	data.Subtree = stringValueOrNull(permission.Ipapermlocation)
+	data.BindType = types.StringValue(permission.Ipapermbindruletype)
+
+	data.Rights, d = stringSetValueOrNull(ctx, permission.Ipapermright)
+	diags.Append(d...)
+	known, d := stringSetElements(ctx, data.Attributes)
+	diags.Append(d...)
+	var attributes []string
+	if permission.Attrs != nil {
+		attributes = matchAttributes(*permission.Attrs, known)
+	}
+	data.Attributes, d = membersSetValue(ctx, data.Attributes, &attributes)
+	diags.Append(d...)
+	data.Filters, d = membersSetValue(ctx, data.Filters, permission.Extratargetfilter)
+	diags.Append(d...)
+	data.Memberof, d = membersSetValue(ctx, data.Memberof, permission.Memberof)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *FreeipaPermissionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create or destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state FreeipaPermissionResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// FreeIPA derives the subtree from the type and the type from the
+	// subtree, so the one left unset changes along with the other.
+	if config.Subtree.IsNull() && !config.Type.IsNull() && !config.Type.Equal(state.Type) {
+		plan.Subtree = types.StringUnknown()
+	}
+	if config.Type.IsNull() && !config.Subtree.IsNull() && !config.Subtree.Equal(state.Subtree) {
+		plan.Type = types.StringUnknown()
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaPermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var rights, attributes, filters, memberof []string
+	for _, s := range []struct {
+		set  types.Set
+		dest *[]string
+	}{
+		{data.Rights, &rights},
+		{data.Attributes, &attributes},
+		{data.Filters, &filters},
+		{data.Memberof, &memberof},
+	} {
+		elems, diags := stringSetElements(ctx, s.set)
+		resp.Diagnostics.Append(diags...)
+		*s.dest = elems
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.PermissionAddOptionalArgs{
+		Ipapermright:        &rights,
+		Attrs:               stringSliceOrNil(attributes),
+		Extratargetfilter:   stringSliceOrNil(filters),
+		Memberof:            stringSliceOrNil(memberof),
+		Ipapermbindruletype: utils.RefString(data.BindType.ValueString()),
+	}
+	if !data.Type.IsUnknown() && !data.Type.IsNull() {
+		optArgs.Type = utils.RefString(data.Type.ValueString())
+	}
+	if !data.Subtree.IsUnknown() && !data.Subtree.IsNull() {
+		optArgs.Ipapermlocation = utils.RefString(data.Subtree.ValueString())
+	}
+
+	permission, err := r.client.PermissionAdd(&freeipa.PermissionAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.setFromPermission(ctx, &permission.Result)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("created permission: %s", data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permission, err := r.client.PermissionShow(&freeipa.PermissionShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.PermissionShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("permission %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromPermission(ctx, &permission.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaPermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.PermissionModOptionalArgs{}
+	changed := false
+
+	// Multi-valued attributes are replaced as a whole, an empty list clears them
+	sets := []struct {
+		plan, state types.Set
+		arg         **[]string
+	}{
+		{plan.Rights, state.Rights, &optArgs.Ipapermright},
+		{plan.Attributes, state.Attributes, &optArgs.Attrs},
+		{plan.Filters, state.Filters, &optArgs.Extratargetfilter},
+		{plan.Memberof, state.Memberof, &optArgs.Memberof},
+	}
+	for _, s := range sets {
+		if s.plan.Equal(s.state) {
+			continue
+		}
+		elems, diags := stringSetElements(ctx, s.plan)
+		resp.Diagnostics.Append(diags...)
+		*s.arg = &elems
+		changed = true
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	strs := []struct {
+		plan, state types.String
+		arg         **string
+	}{
+		{plan.Type, state.Type, &optArgs.Type},
+		{plan.Subtree, state.Subtree, &optArgs.Ipapermlocation},
+		{plan.BindType, state.BindType, &optArgs.Ipapermbindruletype},
+	}
+	for _, s := range strs {
+		if s.plan.IsUnknown() || s.plan.Equal(s.state) {
+			continue
+		}
+		*s.arg = utils.RefString(s.plan.ValueString())
+		changed = true
+	}
+
+	if changed {
+		_, err := r.client.PermissionMod(&freeipa.PermissionModArgs{
+			Cn: state.Name.ValueString(),
+		}, optArgs)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permission %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	permission, err := r.client.PermissionShow(&freeipa.PermissionShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.PermissionShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromPermission(ctx, &permission.Result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.PermissionDel(&freeipa.PermissionDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.PermissionDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaPermissionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaPermissionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.test", "id", "tftestpermission"),
+					resource.TestCheckResourceAttr("freeipa_permission.test", "type", "user"),
+					resource.TestCheckResourceAttr("freeipa_permission.test", "bind_type", "permission"),
+					resource.TestCheckResourceAttrSet("freeipa_permission.test", "subtree"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.test", "rights.*", "write"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.test", "attributes.*", "telephonenumber"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_permission.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestpermission",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaPermissionResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.test", "rights.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_permission.test", "attributes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.test", "attributes.*", "telephoneNumber"),
+					resource.TestCheckTypeSetElemAttr("freeipa_permission.test", "filters.*", "(employeetype=contractor)"),
+				),
+			},
+			// Change the type, the subtree follows
+			{
+				Config: testAccFreeipaPermissionResourceConfigType,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_permission.test", "type", "group"),
+					resource.TestMatchResourceAttr("freeipa_permission.test", "subtree", regexp.MustCompile(`^cn=groups,cn=accounts,`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaPermissionResourceConfig = `
+resource "freeipa_permission" "test" {
+  name       = "tftestpermission"
+  rights     = ["write"]
+  type       = "user"
+  attributes = ["telephonenumber"]
+}
+`
+
+const testAccFreeipaPermissionResourceConfigUpdate = `
+resource "freeipa_permission" "test" {
+  name       = "tftestpermission"
+  rights     = ["read", "write"]
+  type       = "user"
+  attributes = ["telephoneNumber", "mobile"]
+  filters    = ["(employeetype=contractor)"]
+}
+`
+
+const testAccFreeipaPermissionResourceConfigType = `
+resource "freeipa_permission" "test" {
+  name       = "tftestpermission"
+  rights     = ["read", "write"]
+  type       = "group"
+  attributes = ["description"]
+}
+`
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaPrivilegeResource{}
+var _ resource.ResourceWithImportState = &FreeipaPrivilegeResource{}
+
+func NewFreeipaPrivilegeResource() resource.Resource {
+	return &FreeipaPrivilegeResource{}
+}
+
+type FreeipaPrivilegeResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaPrivilegeResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Permissions types.Set    `tfsdk:"permissions"`
+}
+
+func (r *FreeipaPrivilegeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_privilege"
+}
+
+func (r *FreeipaPrivilegeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa privilege resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "privilege identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the privilege",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the privilege",
+				Optional:            true,
+			},
+			"permissions": schema.SetAttribute{
+				MarkdownDescription: "Names of the permissions of the privilege. Permissions not declared here are removed from the privilege",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaPrivilegeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromPrivilege copies the attributes of a FreeIPA privilege entry into the model.
+func (data *FreeipaPrivilegeResourceModel) setFromPrivilege(ctx context.Context, privilege *freeipa.Privilege) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(privilege.Cn)
+	data.Name = types.StringValue(privilege.Cn)
+	data.Description = stringValueOrNull(privilege.Description)
+	data.Permissions, diags = membersSetValue(ctx, data.Permissions, privilege.MemberofPermission)
+
+	return diags
+}
+
+// updatePermissions adds and removes permissions of a privilege.
+func (r *FreeipaPrivilegeResource) updatePermissions(name string, add, remove []string) error {
+	if len(remove) > 0 {
+		res, err := r.client.PrivilegeRemovePermission(&freeipa.PrivilegeRemovePermissionArgs{
+			Cn: name,
+		}, &freeipa.PrivilegeRemovePermissionOptionalArgs{
+			Permission: &remove,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		res, err := r.client.PrivilegeAddPermission(&freeipa.PrivilegeAddPermissionArgs{
+			Cn: name,
+		}, &freeipa.PrivilegeAddPermissionOptionalArgs{
+			Permission: &add,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *FreeipaPrivilegeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaPrivilegeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := stringSetElements(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.PrivilegeAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	_, err := r.client.PrivilegeAdd(&freeipa.PrivilegeAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create privilege %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created privilege: %s", data.Name.ValueString()))
+
+	if err := r.updatePermissions(data.Name.ValueString(), permissions, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add permissions to privilege %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	privilege, err := r.client.PrivilegeShow(&freeipa.PrivilegeShowArgs{
+		Cn: data.Name.ValueString(),
+	}, &freeipa.PrivilegeShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read privilege %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromPrivilege(ctx, &privilege.Result)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaPrivilegeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaPrivilegeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	privilege, err := r.client.PrivilegeShow(&freeipa.PrivilegeShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.PrivilegeShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("privilege %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read privilege %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromPrivilege(ctx, &privilege.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaPrivilegeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaPrivilegeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := stringSetElements(ctx, plan.Permissions)
+	resp.Diagnostics.Append(diags...)
+	have, diags := stringSetElements(ctx, state.Permissions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		_, err := r.client.PrivilegeMod(&freeipa.PrivilegeModArgs{
+			Cn: state.Name.ValueString(),
+		}, &freeipa.PrivilegeModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update privilege %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	add, remove := utils.Diff(have, want)
+	if err := r.updatePermissions(state.Name.ValueString(), add, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permissions of privilege %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	privilege, err := r.client.PrivilegeShow(&freeipa.PrivilegeShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.PrivilegeShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read privilege %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromPrivilege(ctx, &privilege.Result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaPrivilegeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaPrivilegeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.PrivilegeDel(&freeipa.PrivilegeDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.PrivilegeDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete privilege %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaPrivilegeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaPrivilegeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaPrivilegeResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_privilege.test", "id", "tftestprivilege"),
+					resource.TestCheckResourceAttr("freeipa_privilege.test", "description", "Terraform acceptance test"),
+					resource.TestCheckTypeSetElemAttr("freeipa_privilege.test", "permissions.*", "tftestprivilegeperm"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_privilege.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestprivilege",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaPrivilegeResourceConfig = `
+resource "freeipa_permission" "test" {
+  name       = "tftestprivilegeperm"
+  rights     = ["write"]
+  type       = "user"
+  attributes = ["telephonenumber"]
+}
+
+resource "freeipa_privilege" "test" {
+  name        = "tftestprivilege"
+  description = "Terraform acceptance test"
+  permissions = [freeipa_permission.test.name]
+}
+`
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &FreeipaReverseRecordNameFunction{}
+
+func NewFreeipaReverseRecordNameFunction() function.Function {
+	return &FreeipaReverseRecordNameFunction{}
+}
+
+type FreeipaReverseRecordNameFunction struct{}
+
+func (f *FreeipaReverseRecordNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_record_name"
+}
+
+func (f *FreeipaReverseRecordNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Name of the PTR record of an IP address in a reverse zone",
+		MarkdownDescription: "Returns the name of the PTR record of an IP address relative to a reverse DNS zone, " +
+			"such as `1.4` for `10.1.4.1` in the `1.10.in-addr.arpa.` zone. The name is `@` when the address is the apex of the zone. " +
+			"Fails when the address does not belong to the zone",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ip",
+				MarkdownDescription: "IPv4 or IPv6 address",
+			},
+			function.StringParameter{
+				Name:                "zone",
+				MarkdownDescription: "Name of the reverse zone, with or without the trailing dot",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FreeipaReverseRecordNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ip, zone string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ip, &zone))
+	if resp.Error != nil {
+		return
+	}
+
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, fmt.Sprintf("Invalid IP address %q: %s", ip, err)))
+		return
+	}
+
+	name, err := reverseRecordName(addr, zone)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, name))
+}
+
+// reverseRecordName returns the name of the PTR record of an address relative
+// to a reverse zone, or `@` when the address is the apex of the zone.
+func reverseRecordName(addr netip.Addr, zone string) (string, error) {
+	name := strings.TrimSuffix(reversePointerName(addr), ".")
+	suffix := strings.ToLower(strings.TrimSuffix(zone, "."))
+	switch {
+	case name == suffix:
+		return "@", nil
+	case strings.HasSuffix(name, "."+suffix):
+		return name[:len(name)-len(suffix)-1], nil
+	default:
+		return "", fmt.Errorf("IP address %s does not belong to reverse zone %s", addr, zone)
+	}
+}
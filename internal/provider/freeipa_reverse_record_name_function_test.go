@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestReverseRecordName(t *testing.T) {
+	tests := []struct {
+		ip, zone, name string
+		err            bool
+	}{
+		{ip: "10.1.5.7", zone: "10.in-addr.arpa.", name: "7.5.1"},
+		{ip: "10.1.5.7", zone: "1.10.in-addr.arpa.", name: "7.5"},
+		{ip: "10.1.5.7", zone: "5.1.10.in-addr.arpa", name: "7"},
+		{ip: "10.1.5.7", zone: "1.10.IN-ADDR.ARPA.", name: "7.5"},
+		{ip: "192.0.2.1", zone: "1.2.0.192.in-addr.arpa.", name: "@"},
+		{ip: "2001:db8:0:100::1", zone: "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa", name: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0"},
+		{ip: "2001:db8::1", zone: "8.b.d.0.1.0.0.2.ip6.arpa.", name: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0"},
+		{ip: "192.0.2.1", zone: "1.10.in-addr.arpa.", err: true},
+		// Labels only match as a whole
+		{ip: "10.11.5.7", zone: "1.10.in-addr.arpa.", err: true},
+		{ip: "2001:db8::1", zone: "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", err: true},
+	}
+	for _, test := range tests {
+		name, err := reverseRecordName(netip.MustParseAddr(test.ip), test.zone)
+		if test.err {
+			if err == nil {
+				t.Errorf("reverseRecordName(%q, %q) = %q, expected an error", test.ip, test.zone, name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("reverseRecordName(%q, %q): unexpected error: %s", test.ip, test.zone, err)
+		} else if name != test.name {
+			t.Errorf("reverseRecordName(%q, %q) = %q, expected %q", test.ip, test.zone, name, test.name)
+		}
+	}
+}
+
+func TestAccFreeipaReverseRecordNameFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "ipv4" {
+  value = provider::freeipa::reverse_record_name("10.1.5.7", "1.10.in-addr.arpa.")
+}
+
+output "ipv6" {
+  value = provider::freeipa::reverse_record_name("2001:db8:0:100::1", "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("ipv4", "7.5"),
+					resource.TestCheckOutput("ipv6", "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0"),
+				),
+			},
+			{
+				Config: `
+output "outside" {
+  value = provider::freeipa::reverse_record_name("192.0.2.1", "1.10.in-addr.arpa.")
+}
+`,
+				ExpectError: regexp.MustCompile(`does not belong to reverse zone`),
+			},
+		},
+	})
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &FreeipaReverseZoneFunction{}
+
+func NewFreeipaReverseZoneFunction() function.Function {
+	return &FreeipaReverseZoneFunction{}
+}
+
+type FreeipaReverseZoneFunction struct{}
+
+func (f *FreeipaReverseZoneFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "reverse_zone"
+}
+
+func (f *FreeipaReverseZoneFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Reverse DNS zone of a network",
+		MarkdownDescription: "Returns the name of the reverse DNS zone holding the PTR records of a network, such as `2.0.192.in-addr.arpa.` for `192.0.2.0/24`. " +
+			"Reverse zones are delegated on octet boundaries for IPv4 and on nibble boundaries for IPv6, so the prefix length is rounded down: " +
+			"the zone of `10.1.4.0/22` is `1.10.in-addr.arpa.`, the zone of `2001:db8:0:100::/56` is `1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.`",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cidr",
+				MarkdownDescription: "Network in CIDR notation, such as `192.0.2.0/24` or `2001:db8::/48`",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FreeipaReverseZoneFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cidr string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &cidr))
+	if resp.Error != nil {
+		return
+	}
+
+	zone, err := reverseZone(cidr)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, zone))
+}
+
+// reverseZone returns the name of the reverse zone of a network in CIDR
+// notation.
+func reverseZone(cidr string) (string, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return "", fmt.Errorf("Invalid CIDR %q: %s", cidr, err)
+	}
+	if prefix.Addr().Is4In6() {
+		return "", fmt.Errorf("Invalid CIDR %q: IPv4-mapped IPv6 networks are not supported", cidr)
+	}
+	return reverseZoneName(prefix), nil
+}
+
+// reverseZoneName returns the name of the reverse zone of a network, whose
+// prefix length is rounded down to an octet boundary for IPv4 and to a
+// nibble boundary for IPv6.
+func reverseZoneName(prefix netip.Prefix) string {
+	prefix = prefix.Masked()
+	labels := strings.Split(strings.TrimSuffix(reversePointerName(prefix.Addr()), "."), ".")
+	// Labels of the address, followed by the in-addr.arpa or ip6.arpa suffix
+	addressLabels, labelBits := 4, 8
+	if prefix.Addr().Is6() {
+		addressLabels, labelBits = 32, 4
+	}
+	return strings.Join(labels[addressLabels-prefix.Bits()/labelBits:], ".") + "."
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestReverseZone(t *testing.T) {
+	tests := []struct {
+		cidr, zone, err string
+	}{
+		{cidr: "0.0.0.0/0", zone: "in-addr.arpa."},
+		{cidr: "10.0.0.0/8", zone: "10.in-addr.arpa."},
+		{cidr: "172.16.0.0/16", zone: "16.172.in-addr.arpa."},
+		{cidr: "192.0.2.0/24", zone: "2.0.192.in-addr.arpa."},
+		{cidr: "192.0.2.1/32", zone: "1.2.0.192.in-addr.arpa."},
+		// Prefixes rounded down to an octet boundary
+		{cidr: "10.128.0.0/9", zone: "10.in-addr.arpa."},
+		{cidr: "10.1.4.0/22", zone: "1.10.in-addr.arpa."},
+		{cidr: "192.0.2.128/25", zone: "2.0.192.in-addr.arpa."},
+		// Host bits set
+		{cidr: "192.0.2.77/24", zone: "2.0.192.in-addr.arpa."},
+		{cidr: "10.1.7.200/22", zone: "1.10.in-addr.arpa."},
+		// IPv6 nibble boundaries
+		{cidr: "2001:db8::/32", zone: "8.b.d.0.1.0.0.2.ip6.arpa."},
+		{cidr: "2001:db8::/48", zone: "0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		{cidr: "2001:db8:0:100::/56", zone: "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		{cidr: "2001:db8:0:1a0::/60", zone: "a.1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		// IPv6 prefixes rounded down to a nibble boundary
+		{cidr: "2001:db8::/34", zone: "8.b.d.0.1.0.0.2.ip6.arpa."},
+		{cidr: "2001:db8:0:1ff::/58", zone: "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		// IPv6 host bits set
+		{cidr: "2001:db8:0:1ab::1/60", zone: "a.1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."},
+		{cidr: "10.1.4.0", err: "Invalid CIDR"},
+		{cidr: "10.1.4.0/33", err: "Invalid CIDR"},
+		{cidr: "::ffff:10.0.0.0/104", err: "IPv4-mapped IPv6 networks are not supported"},
+	}
+	for _, test := range tests {
+		zone, err := reverseZone(test.cidr)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("reverseZone(%q): expected error %q, got %v", test.cidr, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("reverseZone(%q): unexpected error: %s", test.cidr, err)
+		} else if zone != test.zone {
+			t.Errorf("reverseZone(%q) = %q, expected %q", test.cidr, zone, test.zone)
+		}
+	}
+}
+
+func TestAccFreeipaReverseZoneFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "ipv4" {
+  value = provider::freeipa::reverse_zone("10.1.4.0/22")
+}
+
+output "ipv6" {
+  value = provider::freeipa::reverse_zone("2001:db8:0:100::/56")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("ipv4", "1.10.in-addr.arpa."),
+					resource.TestCheckOutput("ipv6", "1.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa."),
+				),
+			},
+			{
+				Config: `
+output "invalid" {
+  value = provider::freeipa::reverse_zone("10.1.4.0")
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid CIDR`),
+			},
+		},
+	})
+}
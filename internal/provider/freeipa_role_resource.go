@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaRoleResource{}
+var _ resource.ResourceWithImportState = &FreeipaRoleResource{}
+
+func NewFreeipaRoleResource() resource.Resource {
+	return &FreeipaRoleResource{}
+}
+
+type FreeipaRoleResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaRoleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Users       types.Set    `tfsdk:"users"`
+	Groups      types.Set    `tfsdk:"groups"`
+	Hosts       types.Set    `tfsdk:"hosts"`
+	Hostgroups  types.Set    `tfsdk:"hostgroups"`
+	Services    types.Set    `tfsdk:"services"`
+	Privileges  types.Set    `tfsdk:"privileges"`
+}
+
+// roleMembers holds the members and the privileges of a role.
+type roleMembers struct {
+	users, groups, hosts, hostgroups, services, privileges []string
+}
+
+func (r *FreeipaRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_role"
+}
+
+func (r *FreeipaRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa role resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "role identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the role",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the role",
+				Optional:            true,
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the member users",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the member groups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Fqdns of the member hosts",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hostgroups": schema.SetAttribute{
+				MarkdownDescription: "Names of the member hostgroups",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"services": schema.SetAttribute{
+				MarkdownDescription: "Principals of the member services, such as `HTTP/www.example.com`. The realm may be omitted",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"privileges": schema.SetAttribute{
+				MarkdownDescription: "Names of the privileges granted by the role",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// members returns the members and privileges declared in the model.
+func (data *FreeipaRoleResourceModel) members(ctx context.Context) (*roleMembers, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	members := &roleMembers{}
+
+	members.users, d = stringSetElements(ctx, data.Users)
+	diags.Append(d...)
+	members.groups, d = stringSetElements(ctx, data.Groups)
+	diags.Append(d...)
+	members.hosts, d = stringSetElements(ctx, data.Hosts)
+	diags.Append(d...)
+	members.hostgroups, d = stringSetElements(ctx, data.Hostgroups)
+	diags.Append(d...)
+	members.services, d = stringSetElements(ctx, data.Services)
+	diags.Append(d...)
+	members.privileges, d = stringSetElements(ctx, data.Privileges)
+	diags.Append(d...)
+
+	return members, diags
+}
+
+// setFromRole copies the attributes of a FreeIPA role entry into the model.
+func (data *FreeipaRoleResourceModel) setFromRole(ctx context.Context, role *freeipa.Role) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(role.Cn)
+	data.Name = types.StringValue(role.Cn)
+	data.Description = stringValueOrNull(role.Description)
+
+	data.Users, d = membersSetValue(ctx, data.Users, role.MemberUser)
+	diags.Append(d...)
+	data.Groups, d = membersSetValue(ctx, data.Groups, role.MemberGroup)
+	diags.Append(d...)
+	data.Hosts, d = membersSetValue(ctx, data.Hosts, role.MemberHost)
+	diags.Append(d...)
+	data.Hostgroups, d = membersSetValue(ctx, data.Hostgroups, role.MemberHostgroup)
+	diags.Append(d...)
+	var services []string
+	if role.MemberService != nil {
+		services = *role.MemberService
+	}
+	data.Services, d = principalsSetValue(ctx, data.Services, services)
+	diags.Append(d...)
+	data.Privileges, d = membersSetValue(ctx, data.Privileges, role.MemberofPrivilege)
+	diags.Append(d...)
+
+	return diags
+}
+
+// addMembers adds members and privileges to a role.
+func (r *FreeipaRoleResource) addMembers(name string, members *roleMembers) error {
+	if len(members.users)+len(members.groups)+len(members.hosts)+len(members.hostgroups)+len(members.services) > 0 {
+		res, err := r.client.RoleAddMember(&freeipa.RoleAddMemberArgs{Cn: name}, &freeipa.RoleAddMemberOptionalArgs{
+			User:      stringSliceOrNil(members.users),
+			Group:     stringSliceOrNil(members.groups),
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+			Service:   stringSliceOrNil(members.services),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	if len(members.privileges) > 0 {
+		res, err := r.client.RoleAddPrivilege(&freeipa.RoleAddPrivilegeArgs{Cn: name}, &freeipa.RoleAddPrivilegeOptionalArgs{
+			Privilege: &members.privileges,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMembers removes members and privileges from a role.
+func (r *FreeipaRoleResource) removeMembers(name string, members *roleMembers) error {
+	if len(members.users)+len(members.groups)+len(members.hosts)+len(members.hostgroups)+len(members.services) > 0 {
+		res, err := r.client.RoleRemoveMember(&freeipa.RoleRemoveMemberArgs{Cn: name}, &freeipa.RoleRemoveMemberOptionalArgs{
+			User:      stringSliceOrNil(members.users),
+			Group:     stringSliceOrNil(members.groups),
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+			Service:   stringSliceOrNil(members.services),
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(members.privileges) > 0 {
+		res, err := r.client.RoleRemovePrivilege(&freeipa.RoleRemovePrivilegeArgs{Cn: name}, &freeipa.RoleRemovePrivilegeOptionalArgs{
+			Privilege: &members.privileges,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// showRole retrieves a role entry.
+func (r *FreeipaRoleResource) showRole(name string) (*freeipa.Role, error) {
+	role, err := r.client.RoleShow(&freeipa.RoleShowArgs{Cn: name}, &freeipa.RoleShowOptionalArgs{})
+	if err != nil {
+		return nil, err
+	}
+	return &role.Result, nil
+}
+
+func (r *FreeipaRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := data.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	optArgs := &freeipa.RoleAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	_, err := r.client.RoleAdd(&freeipa.RoleAddArgs{Cn: name}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create role %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created role: %s", name))
+
+	if err := r.addMembers(name, members); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to role %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	role, err := r.showRole(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromRole(ctx, role)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.showRole(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("role %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromRole(ctx, role)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaRoleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	have, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+
+	if !plan.Description.Equal(state.Description) {
+		_, err := r.client.RoleMod(&freeipa.RoleModArgs{Cn: name}, &freeipa.RoleModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update role %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	add, remove := &roleMembers{}, &roleMembers{}
+	add.users, remove.users = utils.Diff(have.users, want.users)
+	add.groups, remove.groups = utils.Diff(have.groups, want.groups)
+	add.hosts, remove.hosts = utils.Diff(have.hosts, want.hosts)
+	add.hostgroups, remove.hostgroups = utils.Diff(have.hostgroups, want.hostgroups)
+	add.services, remove.services = utils.Diff(matchPrincipals(have.services, want.services), want.services)
+	add.privileges, remove.privileges = utils.Diff(have.privileges, want.privileges)
+
+	if err := r.removeMembers(name, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from role %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	if err := r.addMembers(name, add); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to role %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	role, err := r.showRole(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromRole(ctx, role)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaRoleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.RoleDel(&freeipa.RoleDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.RoleDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete role %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaRoleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaRoleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role.test", "id", "tftestrole"),
+					resource.TestCheckTypeSetElemAttr("freeipa_role.test", "groups.*", "tftestrolegroup"),
+					resource.TestCheckTypeSetElemAttr("freeipa_role.test", "privileges.*", "User Administrators"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_role.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestrole",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaRoleResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_role.test", "description", "Updated description"),
+					resource.TestCheckResourceAttr("freeipa_role.test", "groups.#", "0"),
+					resource.TestCheckTypeSetElemAttr("freeipa_role.test", "hostgroups.*", "tftestrolehostgroup"),
+					resource.TestCheckTypeSetElemAttr("freeipa_role.test", "services.*", "HTTP/tftest-role.corp.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaRoleResourcePrerequisites = `
+resource "freeipa_group" "test" {
+  name = "tftestrolegroup"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name = "tftestrolehostgroup"
+}
+
+resource "freeipa_service" "test" {
+  principal       = "HTTP/tftest-role.corp.example.com"
+  force           = true
+  skip_host_check = true
+}
+`
+
+const testAccFreeipaRoleResourceConfig = testAccFreeipaRoleResourcePrerequisites + `
+resource "freeipa_role" "test" {
+  name        = "tftestrole"
+  description = "Terraform acceptance test"
+  groups      = [freeipa_group.test.name]
+  privileges  = ["User Administrators"]
+}
+`
+
+const testAccFreeipaRoleResourceConfigUpdate = testAccFreeipaRoleResourcePrerequisites + `
+resource "freeipa_role" "test" {
+  name        = "tftestrole"
+  description = "Updated description"
+  groups      = []
+  hostgroups  = [freeipa_hostgroup.test.name]
+  services    = [freeipa_service.test.principal]
+  privileges  = ["User Administrators"]
+}
+`
@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaSelfserviceResource{}
+var _ resource.ResourceWithImportState = &FreeipaSelfserviceResource{}
+
+func NewFreeipaSelfserviceResource() resource.Resource {
+	return &FreeipaSelfserviceResource{}
+}
+
+type FreeipaSelfserviceResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaSelfserviceResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Permissions types.Set    `tfsdk:"permissions"`
+	Attributes  types.Set    `tfsdk:"attributes"`
+}
+
+// aciPermissionsAttribute returns the schema of the permissions of a
+// self-service or delegation rule, which default to `write`.
+func aciPermissionsAttribute() schema.SetAttribute {
+	return schema.SetAttribute{
+		MarkdownDescription: "Permissions granted on the attributes, among `read`, `write`, `add`, `delete` and `all`. Defaults to `write`",
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("write")})),
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(
+				stringvalidator.OneOf("read", "write", "add", "delete", "all"),
+			),
+		},
+	}
+}
+
+func (r *FreeipaSelfserviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_selfservice"
+}
+
+func (r *FreeipaSelfserviceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa self-service rule resource. Self-service rules define which attributes users may edit on their own entry",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "self-service rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the self-service rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permissions": aciPermissionsAttribute(),
+			"attributes": schema.SetAttribute{
+				MarkdownDescription: "Attributes users may edit. Attribute names are case-insensitive, their configured spelling is kept",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaSelfserviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromSelfservice copies the attributes of a FreeIPA self-service rule into the model.
+func (data *FreeipaSelfserviceResourceModel) setFromSelfservice(ctx context.Context, rule *freeipa.Selfservice) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(rule.Aciname)
+	data.Name = types.StringValue(rule.Aciname)
+	data.Permissions, d = stringSetValueOrNull(ctx, rule.Permissions)
+	diags.Append(d...)
+	known, d := stringSetElements(ctx, data.Attributes)
+	diags.Append(d...)
+	attributes := matchAttributes(rule.Attrs, known)
+	data.Attributes, d = stringSetValueOrNull(ctx, &attributes)
+	diags.Append(d...)
+
+	return diags
+}
+
+func (r *FreeipaSelfserviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaSelfserviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions, diags := stringSetElements(ctx, data.Permissions)
+	resp.Diagnostics.Append(diags...)
+	attributes, diags := stringSetElements(ctx, data.Attributes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.SelfserviceAdd(&freeipa.SelfserviceAddArgs{
+		Aciname: data.Name.ValueString(),
+		Attrs:   attributes,
+	}, &freeipa.SelfserviceAddOptionalArgs{
+		Permissions: &permissions,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create self-service rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(data.setFromSelfservice(ctx, &rule.Result)...)
+
+	tflog.Trace(ctx, fmt.Sprintf("created self-service rule: %s", data.Name.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaSelfserviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaSelfserviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.SelfserviceShow(&freeipa.SelfserviceShowArgs{
+		Aciname: state.Name.ValueString(),
+	}, &freeipa.SelfserviceShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("self-service rule %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read self-service rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromSelfservice(ctx, &rule.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSelfserviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaSelfserviceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.SelfserviceModOptionalArgs{}
+	if !plan.Permissions.Equal(state.Permissions) {
+		permissions, diags := stringSetElements(ctx, plan.Permissions)
+		resp.Diagnostics.Append(diags...)
+		optArgs.Permissions = &permissions
+	}
+	if !plan.Attributes.Equal(state.Attributes) {
+		attributes, diags := stringSetElements(ctx, plan.Attributes)
+		resp.Diagnostics.Append(diags...)
+		optArgs.Attrs = &attributes
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if optArgs.Permissions != nil || optArgs.Attrs != nil {
+		rule, err := r.client.SelfserviceMod(&freeipa.SelfserviceModArgs{
+			Aciname: state.Name.ValueString(),
+		}, optArgs)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update self-service rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+		resp.Diagnostics.Append(state.setFromSelfservice(ctx, &rule.Result)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSelfserviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaSelfserviceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SelfserviceDel(&freeipa.SelfserviceDelArgs{
+		Aciname: data.Name.ValueString(),
+	}, &freeipa.SelfserviceDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete self-service rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaSelfserviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
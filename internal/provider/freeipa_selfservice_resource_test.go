@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaSelfserviceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaSelfserviceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selfservice.test", "id", "tftestselfservice"),
+					resource.TestCheckResourceAttr("freeipa_selfservice.test", "permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_selfservice.test", "permissions.*", "write"),
+					resource.TestCheckTypeSetElemAttr("freeipa_selfservice.test", "attributes.*", "mobile"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_selfservice.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestselfservice",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaSelfserviceResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_selfservice.test", "permissions.#", "2"),
+					resource.TestCheckResourceAttr("freeipa_selfservice.test", "attributes.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_selfservice.test", "attributes.*", "facsimileTelephoneNumber"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaSelfserviceResourceConfig = `
+resource "freeipa_selfservice" "test" {
+  name       = "tftestselfservice"
+  attributes = ["mobile"]
+}
+`
+
+const testAccFreeipaSelfserviceResourceConfigUpdate = `
+resource "freeipa_selfservice" "test" {
+  name        = "tftestselfservice"
+  permissions = ["read", "write"]
+  attributes  = ["mobile", "facsimileTelephoneNumber"]
+}
+`
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaServerLocationResource{}
+var _ resource.ResourceWithImportState = &FreeipaServerLocationResource{}
+
+func NewFreeipaServerLocationResource() resource.Resource {
+	return &FreeipaServerLocationResource{}
+}
+
+// FreeipaServerLocationResource manages the location of an IPA server through
+// the raw JSON-RPC client, as go-freeipa cannot unmarshal server entries.
+type FreeipaServerLocationResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaServerLocationResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Server        types.String `tfsdk:"server"`
+	Location      types.String `tfsdk:"location"`
+	ServiceWeight types.Int64  `tfsdk:"service_weight"`
+}
+
+func (r *FreeipaServerLocationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_location"
+}
+
+func (r *FreeipaServerLocationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa server location resource. Assigns an existing IPA server to a location: destroying this resource removes the server from the location",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "server location identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server": schema.StringAttribute{
+				MarkdownDescription: "Fully qualified name of the IPA server",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.StringAttribute{
+				MarkdownDescription: "Name of the location of the server",
+				Required:            true,
+			},
+			"service_weight": schema.Int64Attribute{
+				MarkdownDescription: "Weight of the server in the SRV records of its location. When unset, the weight of the server is left alone",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaServerLocationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// setFromServer copies the location attributes of a FreeIPA server entry into
+// the model. The service weight is only set when it is managed.
+func (data *FreeipaServerLocationResourceModel) setFromServer(server rpcEntry) {
+	data.Id = types.StringPointerValue(server.value("cn"))
+	data.Server = types.StringPointerValue(server.value("cn"))
+	data.Location = dnsNameValue(data.Location, server.value("ipalocation_location"))
+	if !data.ServiceWeight.IsNull() {
+		data.ServiceWeight = types.Int64PointerValue(server.int64Value("ipaserviceweight"))
+	}
+}
+
+// showServer retrieves a server entry.
+func (r *FreeipaServerLocationResource) showServer(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("server_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// modServer modifies a server with the given options.
+func (r *FreeipaServerLocationResource) modServer(name string, options map[string]interface{}) error {
+	if len(options) == 0 {
+		return nil
+	}
+	err := r.rpc.call("server_mod", []interface{}{name}, options, nil)
+	if isFreeipaError(err, freeipaErrEmptyModlist) {
+		return nil
+	}
+	return err
+}
+
+func (r *FreeipaServerLocationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaServerLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.showServer(data.Server.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", data.Server.String(), err))
+		return
+	}
+	current := FreeipaServerLocationResourceModel{ServiceWeight: data.ServiceWeight}
+	current.setFromServer(server)
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "ipalocation_location", data.Location, current.Location)...)
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "ipaserviceweight", data.ServiceWeight, current.ServiceWeight)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modServer(data.Server.ValueString(), options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the location of server %s, got error: %s", data.Server.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set the location of server: %s", data.Server.ValueString()))
+
+	server, err = r.showServer(data.Server.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", data.Server.String(), err))
+		return
+	}
+	data.setFromServer(server)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaServerLocationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaServerLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	server, err := r.showServer(state.Server.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("server %s not found, removing its location from the state", state.Server.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", state.Server.String(), err))
+		return
+	}
+
+	state.setFromServer(server)
+	if state.Location.IsNull() {
+		tflog.Warn(ctx, fmt.Sprintf("server %s has no location, removing it from the state", state.Server.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaServerLocationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaServerLocationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "ipalocation_location", plan.Location, state.Location)...)
+	if !plan.ServiceWeight.IsNull() {
+		resp.Diagnostics.Append(setRPCOption(ctx, options, "ipaserviceweight", plan.ServiceWeight, state.ServiceWeight)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := r.modServer(state.Server.ValueString(), options); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the location of server %s, got error: %s", state.Server.String(), err))
+		return
+	}
+
+	server, err := r.showServer(state.Server.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read server %s, got error: %s", state.Server.String(), err))
+		return
+	}
+	plan.setFromServer(server)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaServerLocationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaServerLocationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Servers are removed along with their replica, clear the location instead.
+	options := map[string]interface{}{}
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "ipalocation_location", types.StringNull(), data.Location)...)
+	resp.Diagnostics.Append(setRPCOption(ctx, options, "ipaserviceweight", types.Int64Null(), data.ServiceWeight)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	err := r.modServer(data.Server.ValueString(), options)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove the location of server %s, got error: %s", data.Server.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaServerLocationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("server"), req, resp)
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaServerLocationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaServerLocationResourcePrerequisites + testAccFreeipaServerLocationResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_server_location.test", "id", "duba-shp-doma01.corp.example.com"),
+					resource.TestCheckResourceAttr("freeipa_server_location.test", "location", "tftest-srvloc-a"),
+					resource.TestCheckNoResourceAttr("freeipa_server_location.test", "service_weight"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_server_location.test",
+				ImportState:                          true,
+				ImportStateId:                        "duba-shp-doma01.corp.example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "server",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaServerLocationResourcePrerequisites + testAccFreeipaServerLocationResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_server_location.test", "location", "tftest-srvloc-b"),
+					resource.TestCheckResourceAttr("freeipa_server_location.test", "service_weight", "200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaServerLocationResourcePrerequisites = `
+resource "freeipa_location" "a" {
+  name = "tftest-srvloc-a"
+}
+
+resource "freeipa_location" "b" {
+  name = "tftest-srvloc-b"
+}
+`
+
+const testAccFreeipaServerLocationResourceConfig = `
+resource "freeipa_server_location" "test" {
+  server   = "duba-shp-doma01.corp.example.com"
+  location = freeipa_location.a.name
+}
+`
+
+const testAccFreeipaServerLocationResourceConfigUpdate = `
+resource "freeipa_server_location" "test" {
+  server         = "duba-shp-doma01.corp.example.com"
+  location       = freeipa_location.b.name
+  service_weight = 200
+}
+`
@@ -0,0 +1,513 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaServiceResource{}
+var _ resource.ResourceWithImportState = &FreeipaServiceResource{}
+
+func NewFreeipaServiceResource() resource.Resource {
+	return &FreeipaServiceResource{}
+}
+
+// FreeipaServiceResource manages services through the raw JSON-RPC client, as
+// go-freeipa cannot unmarshal service entries without a certificate.
+type FreeipaServiceResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaServiceResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Principal          types.String `tfsdk:"principal"`
+	Force              types.Bool   `tfsdk:"force"`
+	SkipHostCheck      types.Bool   `tfsdk:"skip_host_check"`
+	PacType            types.Set    `tfsdk:"pac_type"`
+	AuthIndicators     types.Set    `tfsdk:"auth_indicators"`
+	RequiresPreAuth    types.Bool   `tfsdk:"requires_pre_auth"`
+	OkAsDelegate       types.Bool   `tfsdk:"ok_as_delegate"`
+	OkToAuthAsDelegate types.Bool   `tfsdk:"ok_to_auth_as_delegate"`
+	PrincipalAliases   types.Set    `tfsdk:"principal_aliases"`
+	ManagedByHosts     types.Set    `tfsdk:"managed_by_hosts"`
+}
+
+func (r *FreeipaServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service"
+}
+
+func (r *FreeipaServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa service resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "service identifier, the canonical principal name including the realm",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "Principal of the service, such as `HTTP/app.example.com`. The realm may be omitted",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"force": schema.BoolAttribute{
+				MarkdownDescription: "Force the creation of the service even if the host name is not in DNS",
+				Optional:            true,
+			},
+			"skip_host_check": schema.BoolAttribute{
+				MarkdownDescription: "Create the service even if the host of the principal does not exist",
+				Optional:            true,
+			},
+			"pac_type": schema.SetAttribute{
+				MarkdownDescription: "Override of the PAC types of the service, among `MS-PAC`, `PAD` and `NONE`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("MS-PAC", "PAD", "NONE"),
+					),
+				},
+			},
+			"auth_indicators": schema.SetAttribute{
+				MarkdownDescription: "Authentication indicators required to get a ticket for the service, among `otp`, `radius`, `pkinit`, `hardened`, `idp` and `passkey`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("otp", "radius", "pkinit", "hardened", "idp", "passkey"),
+					),
+				},
+			},
+			"requires_pre_auth": schema.BoolAttribute{
+				MarkdownDescription: "Whether pre-authentication is required for the service. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"ok_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "Whether clients may trust the service for delegation. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ok_to_auth_as_delegate": schema.BoolAttribute{
+				MarkdownDescription: "Whether the service may authenticate on behalf of a client. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"principal_aliases": schema.SetAttribute{
+				MarkdownDescription: "Alternative principals of the service",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"managed_by_hosts": schema.SetAttribute{
+				MarkdownDescription: "Fqdns of the hosts allowed to manage the service. When unset, FreeIPA sets it to the host of the principal",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FreeipaServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// principalWithoutRealm strips the realm from a Kerberos principal name.
+func principalWithoutRealm(principal string) string {
+	if i := strings.LastIndex(principal, "@"); i >= 0 {
+		return principal[:i]
+	}
+	return principal
+}
+
+// samePrincipal reports whether two principal names designate the same
+// principal, either of them possibly omitting the realm.
+func samePrincipal(a, b string) bool {
+	if strings.Contains(a, "@") && strings.Contains(b, "@") {
+		return a == b
+	}
+	return principalWithoutRealm(a) == principalWithoutRealm(b)
+}
+
+// matchPrincipals returns the principal names returned by FreeIPA, spelled
+// as in known, with or without the realm, for the principals it holds.
+func matchPrincipals(principals, known []string) []string {
+	values := make([]string, 0, len(principals))
+	for _, principal := range principals {
+		for _, k := range known {
+			if samePrincipal(k, principal) {
+				principal = k
+				break
+			}
+		}
+		values = append(values, principal)
+	}
+	return values
+}
+
+// principalsSetValue converts principal names returned by FreeIPA to a
+// Terraform set, keeping the spelling of the prior set, with or without the
+// realm, for the principals it already holds.
+func principalsSetValue(ctx context.Context, prior types.Set, principals []string) (types.Set, diag.Diagnostics) {
+	known, diags := stringSetElements(ctx, prior)
+	values := matchPrincipals(principals, known)
+	res, d := membersSetValue(ctx, prior, &values)
+	diags.Append(d...)
+	return res, diags
+}
+
+// setFromService copies the attributes of a FreeIPA service entry into the model.
+func (data *FreeipaServiceResourceModel) setFromService(ctx context.Context, service rpcEntry) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	canonical := ""
+	if v := service.value("krbcanonicalname"); v != nil {
+		canonical = *v
+	}
+	var aliases []string
+	for _, principal := range service.values("krbprincipalname") {
+		if canonical == "" {
+			canonical = principal
+		}
+		if principal != canonical {
+			aliases = append(aliases, principal)
+		}
+	}
+
+	data.Id = types.StringValue(canonical)
+	if data.Principal.IsNull() || data.Principal.IsUnknown() || !samePrincipal(data.Principal.ValueString(), canonical) {
+		data.Principal = types.StringValue(canonical)
+	}
+
+	data.PrincipalAliases, d = principalsSetValue(ctx, data.PrincipalAliases, aliases)
+	diags.Append(d...)
+	pacType := service.values("ipakrbauthzdata")
+	data.PacType, d = membersSetValue(ctx, data.PacType, &pacType)
+	diags.Append(d...)
+	authIndicators := service.values("krbprincipalauthind")
+	data.AuthIndicators, d = membersSetValue(ctx, data.AuthIndicators, &authIndicators)
+	diags.Append(d...)
+	managedBy := service.values("managedby_host")
+	if len(managedBy) == 0 {
+		data.ManagedByHosts = types.SetValueMust(types.StringType, []attr.Value{})
+	} else {
+		data.ManagedByHosts, d = types.SetValueFrom(ctx, types.StringType, managedBy)
+		diags.Append(d...)
+	}
+
+	data.RequiresPreAuth = types.BoolValue(true)
+	if v := service.boolValue("ipakrbrequirespreauth"); v != nil {
+		data.RequiresPreAuth = types.BoolValue(*v)
+	}
+	data.OkAsDelegate = types.BoolValue(false)
+	if v := service.boolValue("ipakrbokasdelegate"); v != nil {
+		data.OkAsDelegate = types.BoolValue(*v)
+	}
+	data.OkToAuthAsDelegate = types.BoolValue(false)
+	if v := service.boolValue("ipakrboktoauthasdelegate"); v != nil {
+		data.OkToAuthAsDelegate = types.BoolValue(*v)
+	}
+
+	return diags
+}
+
+// showService retrieves a service entry.
+func (r *FreeipaServiceResource) showService(principal string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("service_show", []interface{}{principal}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+// updatePrincipals adds and removes principal aliases of a service.
+func (r *FreeipaServiceResource) updatePrincipals(principal, method string, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+	return r.rpc.call(method, []interface{}{principal, aliases}, nil, nil)
+}
+
+// updateManagedBy adds and removes hosts allowed to manage a service.
+func (r *FreeipaServiceResource) updateManagedBy(principal string, add, remove []string) error {
+	if len(remove) > 0 {
+		var res rpcEntryResult
+		if err := r.rpc.call("service_remove_host", []interface{}{principal}, map[string]interface{}{"host": remove}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		var res rpcEntryResult
+		if err := r.rpc.call("service_add_host", []interface{}{principal}, map[string]interface{}{"host": add}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *FreeipaServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pacType, diags := stringSetElements(ctx, data.PacType)
+	resp.Diagnostics.Append(diags...)
+	authIndicators, diags := stringSetElements(ctx, data.AuthIndicators)
+	resp.Diagnostics.Append(diags...)
+	aliases, diags := stringSetElements(ctx, data.PrincipalAliases)
+	resp.Diagnostics.Append(diags...)
+	managedBy, diags := stringSetElements(ctx, data.ManagedByHosts)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	options := map[string]interface{}{
+		"ipakrbrequirespreauth":    data.RequiresPreAuth.ValueBool(),
+		"ipakrbokasdelegate":       data.OkAsDelegate.ValueBool(),
+		"ipakrboktoauthasdelegate": data.OkToAuthAsDelegate.ValueBool(),
+	}
+	if data.Force.ValueBool() {
+		options["force"] = true
+	}
+	if data.SkipHostCheck.ValueBool() {
+		options["skip_host_check"] = true
+	}
+	if len(pacType) > 0 {
+		options["ipakrbauthzdata"] = pacType
+	}
+	if len(authIndicators) > 0 {
+		options["krbprincipalauthind"] = authIndicators
+	}
+
+	var res rpcEntryResult
+	err := r.rpc.call("service_add", []interface{}{data.Principal.ValueString()}, options, &res)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service %s, got error: %s", data.Principal.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created service: %s", data.Principal.ValueString()))
+
+	principal := data.Principal.ValueString()
+	if err := r.updatePrincipals(principal, "service_add_principal", aliases); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add principal aliases to service %s, got error: %s", data.Principal.String(), err))
+		return
+	}
+	if !data.ManagedByHosts.IsUnknown() {
+		add, remove := utils.Diff(res.Result.values("managedby_host"), managedBy)
+		if err := r.updateManagedBy(principal, add, remove); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hosts managing service %s, got error: %s", data.Principal.String(), err))
+			return
+		}
+	}
+
+	service, err := r.showService(principal)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s, got error: %s", data.Principal.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromService(ctx, service)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	service, err := r.showService(state.Principal.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("service %s not found, removing it from the state", state.Principal.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s, got error: %s", state.Principal.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromService(ctx, service)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaServiceResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principal := state.Principal.ValueString()
+
+	wantAliases, diags := stringSetElements(ctx, plan.PrincipalAliases)
+	resp.Diagnostics.Append(diags...)
+	haveAliases, diags := stringSetElements(ctx, state.PrincipalAliases)
+	resp.Diagnostics.Append(diags...)
+	addAliases, removeAliases := utils.Diff(haveAliases, wantAliases)
+
+	options := map[string]interface{}{}
+	if !plan.PacType.Equal(state.PacType) {
+		pacType, diags := stringSetElements(ctx, plan.PacType)
+		resp.Diagnostics.Append(diags...)
+		// An empty string removes the attribute
+		options["ipakrbauthzdata"] = ""
+		if len(pacType) > 0 {
+			options["ipakrbauthzdata"] = pacType
+		}
+	}
+	if !plan.AuthIndicators.Equal(state.AuthIndicators) {
+		authIndicators, diags := stringSetElements(ctx, plan.AuthIndicators)
+		resp.Diagnostics.Append(diags...)
+		options["krbprincipalauthind"] = ""
+		if len(authIndicators) > 0 {
+			options["krbprincipalauthind"] = authIndicators
+		}
+	}
+	if !plan.RequiresPreAuth.Equal(state.RequiresPreAuth) {
+		options["ipakrbrequirespreauth"] = plan.RequiresPreAuth.ValueBool()
+	}
+	if !plan.OkAsDelegate.Equal(state.OkAsDelegate) {
+		options["ipakrbokasdelegate"] = plan.OkAsDelegate.ValueBool()
+	}
+	if !plan.OkToAuthAsDelegate.Equal(state.OkToAuthAsDelegate) {
+		options["ipakrboktoauthasdelegate"] = plan.OkToAuthAsDelegate.ValueBool()
+	}
+
+	var addHosts, removeHosts []string
+	if !plan.ManagedByHosts.IsUnknown() {
+		wantHosts, diags := stringSetElements(ctx, plan.ManagedByHosts)
+		resp.Diagnostics.Append(diags...)
+		haveHosts, diags := stringSetElements(ctx, state.ManagedByHosts)
+		resp.Diagnostics.Append(diags...)
+		addHosts, removeHosts = utils.Diff(haveHosts, wantHosts)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.updatePrincipals(principal, "service_remove_principal", removeAliases); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove principal aliases from service %s, got error: %s", state.Principal.String(), err))
+		return
+	}
+
+	if len(options) > 0 {
+		err := r.rpc.call("service_mod", []interface{}{principal}, options, nil)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service %s, got error: %s", state.Principal.String(), err))
+			return
+		}
+	}
+
+	if err := r.updatePrincipals(principal, "service_add_principal", addAliases); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add principal aliases to service %s, got error: %s", state.Principal.String(), err))
+		return
+	}
+	if err := r.updateManagedBy(principal, addHosts, removeHosts); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update hosts managing service %s, got error: %s", state.Principal.String(), err))
+		return
+	}
+
+	service, err := r.showService(principal)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service %s, got error: %s", state.Principal.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromService(ctx, service)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaServiceResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("service_del", []interface{}{[]string{data.Principal.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service %s, got error: %s", data.Principal.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("principal"), req, resp)
+}
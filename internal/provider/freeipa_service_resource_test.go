@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaServiceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaServiceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.test", "principal", "HTTP/tftest-service.corp.example.com"),
+					resource.TestCheckResourceAttr("freeipa_service.test", "requires_pre_auth", "true"),
+					resource.TestCheckResourceAttr("freeipa_service.test", "ok_as_delegate", "false"),
+					resource.TestCheckTypeSetElemAttr("freeipa_service.test", "auth_indicators.*", "otp"),
+					resource.TestCheckTypeSetElemAttr("freeipa_service.test", "managed_by_hosts.*", "tftest-service.corp.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_service.test",
+				ImportState:                          true,
+				ImportStateId:                        "HTTP/tftest-service.corp.example.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "principal",
+				ImportStateVerifyIgnore:              []string{"force", "skip_host_check"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaServiceResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_service.test", "ok_as_delegate", "true"),
+					resource.TestCheckResourceAttr("freeipa_service.test", "auth_indicators.#", "0"),
+					resource.TestCheckTypeSetElemAttr("freeipa_service.test", "pac_type.*", "PAD"),
+					resource.TestCheckTypeSetElemAttr("freeipa_service.test", "principal_aliases.*", "HTTP/tftest-service-alias.corp.example.com"),
+					resource.TestCheckResourceAttr("freeipa_service.test", "managed_by_hosts.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaServiceResourcePrerequisites = `
+resource "freeipa_host" "test" {
+  fqdn = "tftest-service.corp.example.com"
+}
+
+resource "freeipa_host" "manager" {
+  fqdn = "tftest-service-manager.corp.example.com"
+}
+`
+
+const testAccFreeipaServiceResourceConfig = testAccFreeipaServiceResourcePrerequisites + `
+resource "freeipa_service" "test" {
+  principal       = "HTTP/${freeipa_host.test.fqdn}"
+  auth_indicators = ["otp"]
+}
+`
+
+const testAccFreeipaServiceResourceConfigUpdate = testAccFreeipaServiceResourcePrerequisites + `
+resource "freeipa_service" "test" {
+  principal         = "HTTP/${freeipa_host.test.fqdn}"
+  pac_type          = ["PAD"]
+  auth_indicators   = []
+  ok_as_delegate    = true
+  principal_aliases = ["HTTP/tftest-service-alias.corp.example.com"]
+  managed_by_hosts  = [freeipa_host.test.fqdn, freeipa_host.manager.fqdn]
+}
+`
@@ -0,0 +1,312 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaServicedelegationRuleResource{}
+var _ resource.ResourceWithImportState = &FreeipaServicedelegationRuleResource{}
+
+func NewFreeipaServicedelegationRuleResource() resource.Resource {
+	return &FreeipaServicedelegationRuleResource{}
+}
+
+// FreeipaServicedelegationRuleResource manages service delegation rules
+// through the raw JSON-RPC client, as go-freeipa expects a single member
+// principal and target in their entries.
+type FreeipaServicedelegationRuleResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaServicedelegationRuleResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Principals types.Set    `tfsdk:"principals"`
+	Targets    types.Set    `tfsdk:"targets"`
+}
+
+func (r *FreeipaServicedelegationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servicedelegation_rule"
+}
+
+func (r *FreeipaServicedelegationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa service delegation rule resource. A rule allows its member principals to impersonate users to the services of its targets",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "service delegation rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service delegation rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principals": schema.SetAttribute{
+				MarkdownDescription: "Principals of the services allowed to impersonate users. The realm may be omitted",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"targets": schema.SetAttribute{
+				MarkdownDescription: "Names of the service delegation targets the principals may access on behalf of users",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaServicedelegationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// updateDelegationPrincipals adds and removes the member principals of a
+// service delegation rule or target, depending on the command prefix.
+func updateDelegationPrincipals(rpc *rpcClient, prefix, name string, add, remove []string) error {
+	if len(remove) > 0 {
+		var res rpcEntryResult
+		if err := rpc.call(prefix+"_remove_member", []interface{}{name}, map[string]interface{}{"principal": remove}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		var res rpcEntryResult
+		if err := rpc.call(prefix+"_add_member", []interface{}{name}, map[string]interface{}{"principal": add}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setFromServicedelegationRule copies the attributes of a FreeIPA service
+// delegation rule entry into the model.
+func (data *FreeipaServicedelegationRuleResourceModel) setFromServicedelegationRule(ctx context.Context, rule rpcEntry) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if name := rule.value("cn"); name != nil {
+		data.Id = types.StringValue(*name)
+		data.Name = types.StringValue(*name)
+	}
+	data.Principals, d = principalsSetValue(ctx, data.Principals, rule.values("memberprincipal"))
+	diags.Append(d...)
+	targets := rule.values("ipaallowedtarget_servicedelegationtarget")
+	data.Targets, d = membersSetValue(ctx, data.Targets, &targets)
+	diags.Append(d...)
+
+	return diags
+}
+
+// updateTargets adds and removes targets of a service delegation rule.
+func (r *FreeipaServicedelegationRuleResource) updateTargets(name string, add, remove []string) error {
+	if len(remove) > 0 {
+		var res rpcEntryResult
+		if err := r.rpc.call("servicedelegationrule_remove_target", []interface{}{name}, map[string]interface{}{"servicedelegationtarget": remove}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		var res rpcEntryResult
+		if err := r.rpc.call("servicedelegationrule_add_target", []interface{}{name}, map[string]interface{}{"servicedelegationtarget": add}, &res); err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// showServicedelegationRule retrieves a service delegation rule entry.
+func (r *FreeipaServicedelegationRuleResource) showServicedelegationRule(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("servicedelegationrule_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaServicedelegationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaServicedelegationRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principals, diags := stringSetElements(ctx, data.Principals)
+	resp.Diagnostics.Append(diags...)
+	targets, diags := stringSetElements(ctx, data.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if err := r.rpc.call("servicedelegationrule_add", []interface{}{name}, nil, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created service delegation rule: %s", name))
+
+	if err := updateDelegationPrincipals(r.rpc, "servicedelegationrule", name, principals, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add principals to service delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	if err := r.updateTargets(name, targets, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add targets to service delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showServicedelegationRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromServicedelegationRule(ctx, rule)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaServicedelegationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaServicedelegationRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.showServicedelegationRule(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("service delegation rule %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromServicedelegationRule(ctx, rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaServicedelegationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaServicedelegationRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wantPrincipals, diags := stringSetElements(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	havePrincipals, diags := stringSetElements(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	wantTargets, diags := stringSetElements(ctx, plan.Targets)
+	resp.Diagnostics.Append(diags...)
+	haveTargets, diags := stringSetElements(ctx, state.Targets)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	addPrincipals, removePrincipals := utils.Diff(havePrincipals, wantPrincipals)
+	if err := updateDelegationPrincipals(r.rpc, "servicedelegationrule", name, addPrincipals, removePrincipals); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update principals of service delegation rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	addTargets, removeTargets := utils.Diff(haveTargets, wantTargets)
+	if err := r.updateTargets(name, addTargets, removeTargets); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update targets of service delegation rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showServicedelegationRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromServicedelegationRule(ctx, rule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaServicedelegationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaServicedelegationRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("servicedelegationrule_del", []interface{}{[]string{data.Name.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service delegation rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaServicedelegationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
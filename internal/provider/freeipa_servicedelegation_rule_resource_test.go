@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaServicedelegationRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaServicedelegationRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_rule.test", "id", "tftestsdrule"),
+					resource.TestCheckTypeSetElemAttr("freeipa_servicedelegation_rule.test", "principals.*", "HTTP/tftest-sdrule-web.corp.example.com"),
+					resource.TestCheckTypeSetElemAttr("freeipa_servicedelegation_rule.test", "targets.*", "tftestsdruletarget"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_servicedelegation_rule.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestsdrule",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				// Imported principals include the realm
+				ImportStateVerifyIgnore: []string{"principals"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaServicedelegationRuleResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_rule.test", "principals.#", "0"),
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_rule.test", "targets.#", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaServicedelegationRuleResourcePrerequisites = `
+resource "freeipa_service" "web" {
+  principal       = "HTTP/tftest-sdrule-web.corp.example.com"
+  force           = true
+  skip_host_check = true
+}
+
+resource "freeipa_service" "db" {
+  principal       = "postgres/tftest-sdrule-db.corp.example.com"
+  force           = true
+  skip_host_check = true
+}
+
+resource "freeipa_servicedelegation_target" "test" {
+  name       = "tftestsdruletarget"
+  principals = [freeipa_service.db.principal]
+}
+`
+
+const testAccFreeipaServicedelegationRuleResourceConfig = testAccFreeipaServicedelegationRuleResourcePrerequisites + `
+resource "freeipa_servicedelegation_rule" "test" {
+  name       = "tftestsdrule"
+  principals = [freeipa_service.web.principal]
+  targets    = [freeipa_servicedelegation_target.test.name]
+}
+`
+
+const testAccFreeipaServicedelegationRuleResourceConfigUpdate = testAccFreeipaServicedelegationRuleResourcePrerequisites + `
+resource "freeipa_servicedelegation_rule" "test" {
+  name       = "tftestsdrule"
+  principals = []
+  targets    = [freeipa_servicedelegation_target.test.name]
+}
+`
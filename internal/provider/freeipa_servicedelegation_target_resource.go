@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaServicedelegationTargetResource{}
+var _ resource.ResourceWithImportState = &FreeipaServicedelegationTargetResource{}
+
+func NewFreeipaServicedelegationTargetResource() resource.Resource {
+	return &FreeipaServicedelegationTargetResource{}
+}
+
+// FreeipaServicedelegationTargetResource manages service delegation targets
+// through the raw JSON-RPC client, as go-freeipa expects a single member
+// principal in their entries.
+type FreeipaServicedelegationTargetResource struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
+type FreeipaServicedelegationTargetResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Principals types.Set    `tfsdk:"principals"`
+}
+
+func (r *FreeipaServicedelegationTargetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servicedelegation_target"
+}
+
+func (r *FreeipaServicedelegationTargetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa service delegation target resource. A target lists the service principals that may be accessed on behalf of users through constrained delegation",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "service delegation target identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service delegation target",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principals": schema.SetAttribute{
+				MarkdownDescription: "Principals of the target services. The realm may be omitted",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaServicedelegationTargetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+	r.rpc = data.rpc
+}
+
+// setFromServicedelegationTarget copies the attributes of a FreeIPA service
+// delegation target entry into the model.
+func (data *FreeipaServicedelegationTargetResourceModel) setFromServicedelegationTarget(ctx context.Context, target rpcEntry) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if name := target.value("cn"); name != nil {
+		data.Id = types.StringValue(*name)
+		data.Name = types.StringValue(*name)
+	}
+	data.Principals, diags = principalsSetValue(ctx, data.Principals, target.values("memberprincipal"))
+
+	return diags
+}
+
+// showServicedelegationTarget retrieves a service delegation target entry.
+func (r *FreeipaServicedelegationTargetResource) showServicedelegationTarget(name string) (rpcEntry, error) {
+	var res rpcEntryResult
+	if err := r.rpc.call("servicedelegationtarget_show", []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Result, nil
+}
+
+func (r *FreeipaServicedelegationTargetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaServicedelegationTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	principals, diags := stringSetElements(ctx, data.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if err := r.rpc.call("servicedelegationtarget_add", []interface{}{name}, nil, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service delegation target %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created service delegation target: %s", name))
+
+	if err := updateDelegationPrincipals(r.rpc, "servicedelegationtarget", name, principals, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add principals to service delegation target %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	target, err := r.showServicedelegationTarget(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation target %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromServicedelegationTarget(ctx, target)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaServicedelegationTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaServicedelegationTargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target, err := r.showServicedelegationTarget(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("service delegation target %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation target %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromServicedelegationTarget(ctx, target)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaServicedelegationTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaServicedelegationTargetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := stringSetElements(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	have, diags := stringSetElements(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	add, remove := utils.Diff(have, want)
+	if err := updateDelegationPrincipals(r.rpc, "servicedelegationtarget", name, add, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update principals of service delegation target %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	target, err := r.showServicedelegationTarget(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read service delegation target %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromServicedelegationTarget(ctx, target)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaServicedelegationTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaServicedelegationTargetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.rpc.call("servicedelegationtarget_del", []interface{}{[]string{data.Name.ValueString()}}, nil, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service delegation target %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaServicedelegationTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaServicedelegationTargetResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaServicedelegationTargetResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_target.test", "id", "tftestsdtarget"),
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_target.test", "principals.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_servicedelegation_target.test", "principals.*", "HTTP/tftest-sdtarget.corp.example.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_servicedelegation_target.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestsdtarget",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+				// Imported principals include the realm
+				ImportStateVerifyIgnore: []string{"principals"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaServicedelegationTargetResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_servicedelegation_target.test", "principals.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+const testAccFreeipaServicedelegationTargetResourcePrerequisites = `
+resource "freeipa_service" "test" {
+  principal       = "HTTP/tftest-sdtarget.corp.example.com"
+  force           = true
+  skip_host_check = true
+}
+`
+
+const testAccFreeipaServicedelegationTargetResourceConfig = testAccFreeipaServicedelegationTargetResourcePrerequisites + `
+resource "freeipa_servicedelegation_target" "test" {
+  name       = "tftestsdtarget"
+  principals = [freeipa_service.test.principal]
+}
+`
+
+const testAccFreeipaServicedelegationTargetResourceConfigUpdate = testAccFreeipaServicedelegationTargetResourcePrerequisites + `
+resource "freeipa_servicedelegation_target" "test" {
+  name       = "tftestsdtarget"
+  principals = []
+}
+`
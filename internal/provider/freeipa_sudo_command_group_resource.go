@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaSudoCommandGroupResource{}
+var _ resource.ResourceWithImportState = &FreeipaSudoCommandGroupResource{}
+
+func NewFreeipaSudoCommandGroupResource() resource.Resource {
+	return &FreeipaSudoCommandGroupResource{}
+}
+
+type FreeipaSudoCommandGroupResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaSudoCommandGroupResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Commands    types.Set    `tfsdk:"commands"`
+}
+
+func (r *FreeipaSudoCommandGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sudo_command_group"
+}
+
+func (r *FreeipaSudoCommandGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa sudo command group resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "sudo command group identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the sudo command group",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the sudo command group",
+				Optional:            true,
+			},
+			"commands": schema.SetAttribute{
+				MarkdownDescription: "Sudo commands of the group. Commands not declared here are removed from the group",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaSudoCommandGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// setFromSudoCommandGroup copies the attributes of a FreeIPA sudo command group entry into the model.
+func (data *FreeipaSudoCommandGroupResourceModel) setFromSudoCommandGroup(ctx context.Context, group *freeipa.Sudocmdgroup) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(group.Cn)
+	data.Name = types.StringValue(group.Cn)
+	data.Description = stringValueOrNull(group.Description)
+	data.Commands, diags = membersSetValue(ctx, data.Commands, group.MemberSudocmd)
+
+	return diags
+}
+
+// updateCommands adds and removes member commands of a sudo command group.
+func (r *FreeipaSudoCommandGroupResource) updateCommands(name string, add, remove []string) error {
+	if len(remove) > 0 {
+		res, err := r.client.SudocmdgroupRemoveMember(&freeipa.SudocmdgroupRemoveMemberArgs{
+			Cn: name,
+		}, &freeipa.SudocmdgroupRemoveMemberOptionalArgs{
+			Sudocmd: &remove,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	if len(add) > 0 {
+		res, err := r.client.SudocmdgroupAddMember(&freeipa.SudocmdgroupAddMemberArgs{
+			Cn: name,
+		}, &freeipa.SudocmdgroupAddMemberOptionalArgs{
+			Sudocmd: &add,
+		})
+		if err != nil {
+			return err
+		}
+		if err := failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *FreeipaSudoCommandGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaSudoCommandGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	commands, diags := stringSetElements(ctx, data.Commands)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.SudocmdgroupAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	_, err := r.client.SudocmdgroupAdd(&freeipa.SudocmdgroupAddArgs{
+		Cn: data.Name.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sudo command group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created sudo command group: %s", data.Name.ValueString()))
+
+	if err := r.updateCommands(data.Name.ValueString(), commands, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add commands to sudo command group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	group, err := r.client.SudocmdgroupShow(&freeipa.SudocmdgroupShowArgs{
+		Cn: data.Name.ValueString(),
+	}, &freeipa.SudocmdgroupShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo command group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromSudoCommandGroup(ctx, &group.Result)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaSudoCommandGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaSudoCommandGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.SudocmdgroupShow(&freeipa.SudocmdgroupShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.SudocmdgroupShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("sudo command group %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo command group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromSudoCommandGroup(ctx, &group.Result)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSudoCommandGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaSudoCommandGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := stringSetElements(ctx, plan.Commands)
+	resp.Diagnostics.Append(diags...)
+	have, diags := stringSetElements(ctx, state.Commands)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		_, err := r.client.SudocmdgroupMod(&freeipa.SudocmdgroupModArgs{
+			Cn: state.Name.ValueString(),
+		}, &freeipa.SudocmdgroupModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sudo command group %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	add, remove := utils.Diff(have, want)
+	if err := r.updateCommands(state.Name.ValueString(), add, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update commands of sudo command group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	group, err := r.client.SudocmdgroupShow(&freeipa.SudocmdgroupShowArgs{
+		Cn: state.Name.ValueString(),
+	}, &freeipa.SudocmdgroupShowOptionalArgs{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo command group %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromSudoCommandGroup(ctx, &group.Result)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaSudoCommandGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaSudoCommandGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SudocmdgroupDel(&freeipa.SudocmdgroupDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.SudocmdgroupDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sudo command group %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaSudoCommandGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
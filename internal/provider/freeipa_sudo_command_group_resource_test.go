@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaSudoCommandGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaSudoCommandGroupResourceConfig(`[freeipa_sudo_command.less.command, freeipa_sudo_command.tail.command]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_command_group.test", "id", "tftestsudocmdgroup"),
+					resource.TestCheckResourceAttr("freeipa_sudo_command_group.test", "commands.#", "2"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_command_group.test", "commands.*", "/usr/bin/tftestless"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_sudo_command_group.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestsudocmdgroup",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaSudoCommandGroupResourceConfig(`[freeipa_sudo_command.tail.command]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_command_group.test", "commands.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_command_group.test", "commands.*", "/usr/bin/tftesttail"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaSudoCommandGroupResourceConfig(commands string) string {
+	return fmt.Sprintf(`
+resource "freeipa_sudo_command" "less" {
+  command = "/usr/bin/tftestless"
+}
+
+resource "freeipa_sudo_command" "tail" {
+  command = "/usr/bin/tftesttail"
+}
+
+resource "freeipa_sudo_command_group" "test" {
+  name        = "tftestsudocmdgroup"
+  description = "Terraform acceptance test"
+  commands    = %[1]s
+}
+`, commands)
+}
@@ -0,0 +1,203 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaSudoCommandResource{}
+var _ resource.ResourceWithImportState = &FreeipaSudoCommandResource{}
+
+func NewFreeipaSudoCommandResource() resource.Resource {
+	return &FreeipaSudoCommandResource{}
+}
+
+type FreeipaSudoCommandResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaSudoCommandResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Command     types.String `tfsdk:"command"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (r *FreeipaSudoCommandResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sudo_command"
+}
+
+func (r *FreeipaSudoCommandResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa sudo command resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "sudo command identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"command": schema.StringAttribute{
+				MarkdownDescription: "Full path of the command, such as `/usr/bin/systemctl`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the sudo command",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaSudoCommandResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FreeipaSudoCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaSudoCommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := &freeipa.SudocmdAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+
+	command, err := r.client.SudocmdAdd(&freeipa.SudocmdAddArgs{
+		Sudocmd: data.Command.ValueString(),
+	}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sudo command %s, got error: %s", data.Command.String(), err))
+		return
+	}
+
+	data.setFromSudoCommand(&command.Result)
+
+	tflog.Trace(ctx, fmt.Sprintf("created sudo command: %s", data.Command.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setFromSudoCommand copies the attributes of a FreeIPA sudo command entry into the model.
+func (data *FreeipaSudoCommandResourceModel) setFromSudoCommand(command *freeipa.Sudocmd) {
+	data.Id = types.StringValue(command.Sudocmd)
+	data.Command = types.StringValue(command.Sudocmd)
+	data.Description = stringValueOrNull(command.Description)
+}
+
+func (r *FreeipaSudoCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaSudoCommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	command, err := r.client.SudocmdShow(&freeipa.SudocmdShowArgs{
+		Sudocmd: state.Command.ValueString(),
+	}, &freeipa.SudocmdShowOptionalArgs{})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("sudo command %s not found, removing it from the state", state.Command.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo command %s, got error: %s", state.Command.String(), err))
+		return
+	}
+
+	state.setFromSudoCommand(&command.Result)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSudoCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaSudoCommandResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		command, err := r.client.SudocmdMod(&freeipa.SudocmdModArgs{
+			Sudocmd: state.Command.ValueString(),
+		}, &freeipa.SudocmdModOptionalArgs{
+			Description: utils.RefString(plan.Description.ValueString()),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sudo command %s, got error: %s", state.Command.String(), err))
+			return
+		}
+		state.setFromSudoCommand(&command.Result)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSudoCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaSudoCommandResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SudocmdDel(&freeipa.SudocmdDelArgs{
+		Sudocmd: []string{data.Command.ValueString()},
+	}, &freeipa.SudocmdDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sudo command %s, got error: %s", data.Command.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaSudoCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("command"), req, resp)
+}
@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaSudoCommandResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaSudoCommandResourceConfig("Terraform acceptance test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_command.test", "id", "/usr/bin/tftestsudocmd"),
+					resource.TestCheckResourceAttr("freeipa_sudo_command.test", "description", "Terraform acceptance test"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_sudo_command.test",
+				ImportState:                          true,
+				ImportStateId:                        "/usr/bin/tftestsudocmd",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "command",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaSudoCommandResourceConfig("Updated description"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_command.test", "description", "Updated description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccFreeipaSudoCommandResourceConfig(description string) string {
+	return fmt.Sprintf(`
+resource "freeipa_sudo_command" "test" {
+  command     = "/usr/bin/tftestsudocmd"
+  description = %[1]q
+}
+`, description)
+}
@@ -0,0 +1,762 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaSudoRuleResource{}
+var _ resource.ResourceWithImportState = &FreeipaSudoRuleResource{}
+var _ resource.ResourceWithModifyPlan = &FreeipaSudoRuleResource{}
+
+func NewFreeipaSudoRuleResource() resource.Resource {
+	return &FreeipaSudoRuleResource{}
+}
+
+type FreeipaSudoRuleResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaSudoRuleResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	SudoOrder          types.Int64  `tfsdk:"sudo_order"`
+	UserCategory       types.String `tfsdk:"usercategory"`
+	HostCategory       types.String `tfsdk:"hostcategory"`
+	CmdCategory        types.String `tfsdk:"cmdcategory"`
+	RunasUserCategory  types.String `tfsdk:"runasusercategory"`
+	RunasGroupCategory types.String `tfsdk:"runasgroupcategory"`
+	Options            types.Set    `tfsdk:"options"`
+	Users              types.Set    `tfsdk:"users"`
+	Groups             types.Set    `tfsdk:"groups"`
+	Hosts              types.Set    `tfsdk:"hosts"`
+	Hostgroups         types.Set    `tfsdk:"hostgroups"`
+	AllowCommands      types.Set    `tfsdk:"allow_commands"`
+	AllowCommandGroups types.Set    `tfsdk:"allow_command_groups"`
+	DenyCommands       types.Set    `tfsdk:"deny_commands"`
+	DenyCommandGroups  types.Set    `tfsdk:"deny_command_groups"`
+	RunasUsers         types.Set    `tfsdk:"runas_users"`
+	RunasGroups        types.Set    `tfsdk:"runas_groups"`
+}
+
+// sudoRuleMembers holds the members of a sudo rule by member type.
+type sudoRuleMembers struct {
+	users, groups, hosts, hostgroups                                   []string
+	allowCommands, allowCommandGroups, denyCommands, denyCommandGroups []string
+	runasUsers, runasGroups                                            []string
+}
+
+func (r *FreeipaSudoRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sudo_rule"
+}
+
+func (r *FreeipaSudoRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa sudo rule resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "sudo rule identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the sudo rule",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the sudo rule",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the sudo rule is enabled. Defaults to `true`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"sudo_order": schema.Int64Attribute{
+				MarkdownDescription: "Order of the rule: rules with a higher order take precedence. Must be unique among the sudo rules",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"usercategory": schema.StringAttribute{
+				MarkdownDescription: "User category the rule applies to, only `all` is supported. Conflicts with `users` and `groups`",
+				Optional:            true,
+				Validators:          categoryValidators("users", "groups"),
+			},
+			"hostcategory": schema.StringAttribute{
+				MarkdownDescription: "Host category the rule applies to, only `all` is supported. Conflicts with `hosts` and `hostgroups`",
+				Optional:            true,
+				Validators:          categoryValidators("hosts", "hostgroups"),
+			},
+			"cmdcategory": schema.StringAttribute{
+				MarkdownDescription: "Command category the rule applies to, only `all` is supported. Conflicts with `allow_commands` and `allow_command_groups`",
+				Optional:            true,
+				Validators:          categoryValidators("allow_commands", "allow_command_groups"),
+			},
+			"runasusercategory": schema.StringAttribute{
+				MarkdownDescription: "Run-as user category the rule applies to, only `all` is supported. Conflicts with `runas_users`",
+				Optional:            true,
+				Validators:          categoryValidators("runas_users"),
+			},
+			"runasgroupcategory": schema.StringAttribute{
+				MarkdownDescription: "Run-as group category the rule applies to, only `all` is supported. Conflicts with `runas_groups`",
+				Optional:            true,
+				Validators:          categoryValidators("runas_groups"),
+			},
+			"options": schema.SetAttribute{
+				MarkdownDescription: "Sudo options of the rule, such as `!authenticate` or `env_keep+=SSH_AUTH_SOCK`. Options are stored exactly as written, one option per element",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(regexp.MustCompile(`^\S(.*\S)?$`), "must not be empty nor start or end with whitespace"),
+					),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the users the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the user groups the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hosts": schema.SetAttribute{
+				MarkdownDescription: "Fqdns of the hosts the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"hostgroups": schema.SetAttribute{
+				MarkdownDescription: "Names of the hostgroups the rule applies to",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"allow_commands": schema.SetAttribute{
+				MarkdownDescription: "Sudo commands the rule allows",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"allow_command_groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the sudo command groups the rule allows",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"deny_commands": schema.SetAttribute{
+				MarkdownDescription: "Sudo commands the rule denies",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"deny_command_groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the sudo command groups the rule denies",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"runas_users": schema.SetAttribute{
+				MarkdownDescription: "Logins of the users commands may be run as",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"runas_groups": schema.SetAttribute{
+				MarkdownDescription: "Names of the groups commands may be run as",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *FreeipaSudoRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+func (r *FreeipaSudoRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FreeipaSudoRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Name.IsUnknown() || plan.SudoOrder.IsUnknown() || plan.SudoOrder.IsNull() {
+		return
+	}
+	name := plan.Name.ValueString()
+	order := plan.SudoOrder.ValueInt64()
+
+	// The client is not configured yet when the provider configuration is
+	// unknown, and FreeIPA already ensures an existing order is unique.
+	if r.client == nil {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		var state FreeipaSudoRuleResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || state.SudoOrder.Equal(plan.SudoOrder) {
+			return
+		}
+	}
+
+	rules, err := r.client.SudoruleFind("", &freeipa.SudoruleFindArgs{}, &freeipa.SudoruleFindOptionalArgs{
+		Sudoorder: utils.RefInt(int(order)),
+		PkeyOnly:  utils.RefBool(true),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search sudo rules with order %d, got error: %s", order, err))
+		return
+	}
+	for _, rule := range rules.Result {
+		if rule.Cn != name {
+			resp.Diagnostics.AddAttributeError(path.Root("sudo_order"), "Duplicate sudo order", fmt.Sprintf("Sudo order %d is already used by sudo rule %q", order, rule.Cn))
+			return
+		}
+	}
+}
+
+// members returns the members declared in the model.
+func (data *FreeipaSudoRuleResourceModel) members(ctx context.Context) (*sudoRuleMembers, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+	members := &sudoRuleMembers{}
+
+	for _, m := range []struct {
+		set  types.Set
+		dest *[]string
+	}{
+		{data.Users, &members.users},
+		{data.Groups, &members.groups},
+		{data.Hosts, &members.hosts},
+		{data.Hostgroups, &members.hostgroups},
+		{data.AllowCommands, &members.allowCommands},
+		{data.AllowCommandGroups, &members.allowCommandGroups},
+		{data.DenyCommands, &members.denyCommands},
+		{data.DenyCommandGroups, &members.denyCommandGroups},
+		{data.RunasUsers, &members.runasUsers},
+		{data.RunasGroups, &members.runasGroups},
+	} {
+		*m.dest, d = stringSetElements(ctx, m.set)
+		diags.Append(d...)
+	}
+
+	return members, diags
+}
+
+// diffSudoRuleMembers returns the members to add and to remove to go from have to want.
+func diffSudoRuleMembers(have, want *sudoRuleMembers) (add, remove *sudoRuleMembers) {
+	add, remove = &sudoRuleMembers{}, &sudoRuleMembers{}
+	add.users, remove.users = utils.Diff(have.users, want.users)
+	add.groups, remove.groups = utils.Diff(have.groups, want.groups)
+	add.hosts, remove.hosts = utils.Diff(have.hosts, want.hosts)
+	add.hostgroups, remove.hostgroups = utils.Diff(have.hostgroups, want.hostgroups)
+	add.allowCommands, remove.allowCommands = utils.Diff(have.allowCommands, want.allowCommands)
+	add.allowCommandGroups, remove.allowCommandGroups = utils.Diff(have.allowCommandGroups, want.allowCommandGroups)
+	add.denyCommands, remove.denyCommands = utils.Diff(have.denyCommands, want.denyCommands)
+	add.denyCommandGroups, remove.denyCommandGroups = utils.Diff(have.denyCommandGroups, want.denyCommandGroups)
+	add.runasUsers, remove.runasUsers = utils.Diff(have.runasUsers, want.runasUsers)
+	add.runasGroups, remove.runasGroups = utils.Diff(have.runasGroups, want.runasGroups)
+	return add, remove
+}
+
+// setFromSudoRule copies the attributes of a FreeIPA sudo rule entry into the model.
+func (data *FreeipaSudoRuleResourceModel) setFromSudoRule(ctx context.Context, rule *freeipa.Sudorule) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(rule.Cn)
+	data.Name = types.StringValue(rule.Cn)
+	data.Description = stringValueOrNull(rule.Description)
+	data.Enabled = types.BoolValue(rule.Ipaenabledflag == nil || *rule.Ipaenabledflag)
+	data.SudoOrder = int64ValueOrNull(rule.Sudoorder)
+	data.UserCategory = stringValueOrNull(rule.Usercategory)
+	data.HostCategory = stringValueOrNull(rule.Hostcategory)
+	data.CmdCategory = stringValueOrNull(rule.Cmdcategory)
+	data.RunasUserCategory = stringValueOrNull(rule.Ipasudorunasusercategory)
+	data.RunasGroupCategory = stringValueOrNull(rule.Ipasudorunasgroupcategory)
+
+	for _, m := range []struct {
+		set     *types.Set
+		members *[]string
+	}{
+		{&data.Options, rule.Ipasudoopt},
+		{&data.Users, rule.MemberuserUser},
+		{&data.Groups, rule.MemberuserGroup},
+		{&data.Hosts, rule.MemberhostHost},
+		{&data.Hostgroups, rule.MemberhostHostgroup},
+		{&data.AllowCommands, rule.MemberallowcmdSudocmd},
+		{&data.AllowCommandGroups, rule.MemberallowcmdSudocmdgroup},
+		{&data.DenyCommands, rule.MemberdenycmdSudocmd},
+		{&data.DenyCommandGroups, rule.MemberdenycmdSudocmdgroup},
+		{&data.RunasUsers, rule.IpasudorunasUser},
+		{&data.RunasGroups, rule.IpasudorunasgroupGroup},
+	} {
+		*m.set, d = membersSetValue(ctx, *m.set, m.members)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// addMembers adds users, hosts, commands and run-as users and groups to a sudo rule.
+func (r *FreeipaSudoRuleResource) addMembers(name string, members *sudoRuleMembers) error {
+	var failures []freeipa.FailedOperations
+
+	if len(members.users)+len(members.groups) > 0 {
+		res, err := r.client.SudoruleAddUser(&freeipa.SudoruleAddUserArgs{Cn: name}, &freeipa.SudoruleAddUserOptionalArgs{
+			User:  stringSliceOrNil(members.users),
+			Group: stringSliceOrNil(members.groups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.hosts)+len(members.hostgroups) > 0 {
+		res, err := r.client.SudoruleAddHost(&freeipa.SudoruleAddHostArgs{Cn: name}, &freeipa.SudoruleAddHostOptionalArgs{
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.allowCommands)+len(members.allowCommandGroups) > 0 {
+		res, err := r.client.SudoruleAddAllowCommand(&freeipa.SudoruleAddAllowCommandArgs{Cn: name}, &freeipa.SudoruleAddAllowCommandOptionalArgs{
+			Sudocmd:      stringSliceOrNil(members.allowCommands),
+			Sudocmdgroup: stringSliceOrNil(members.allowCommandGroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.denyCommands)+len(members.denyCommandGroups) > 0 {
+		res, err := r.client.SudoruleAddDenyCommand(&freeipa.SudoruleAddDenyCommandArgs{Cn: name}, &freeipa.SudoruleAddDenyCommandOptionalArgs{
+			Sudocmd:      stringSliceOrNil(members.denyCommands),
+			Sudocmdgroup: stringSliceOrNil(members.denyCommandGroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.runasUsers) > 0 {
+		res, err := r.client.SudoruleAddRunasuser(&freeipa.SudoruleAddRunasuserArgs{Cn: name}, &freeipa.SudoruleAddRunasuserOptionalArgs{
+			User: &members.runasUsers,
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.runasGroups) > 0 {
+		res, err := r.client.SudoruleAddRunasgroup(&freeipa.SudoruleAddRunasgroupArgs{Cn: name}, &freeipa.SudoruleAddRunasgroupOptionalArgs{
+			Group: &members.runasGroups,
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+
+	for _, failed := range failures {
+		if err := failedMembersError(failed, freeipa.FailedReasonAlreadyAMember); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeMembers removes users, hosts, commands and run-as users and groups from a sudo rule.
+func (r *FreeipaSudoRuleResource) removeMembers(name string, members *sudoRuleMembers) error {
+	var failures []freeipa.FailedOperations
+
+	if len(members.users)+len(members.groups) > 0 {
+		res, err := r.client.SudoruleRemoveUser(&freeipa.SudoruleRemoveUserArgs{Cn: name}, &freeipa.SudoruleRemoveUserOptionalArgs{
+			User:  stringSliceOrNil(members.users),
+			Group: stringSliceOrNil(members.groups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.hosts)+len(members.hostgroups) > 0 {
+		res, err := r.client.SudoruleRemoveHost(&freeipa.SudoruleRemoveHostArgs{Cn: name}, &freeipa.SudoruleRemoveHostOptionalArgs{
+			Host:      stringSliceOrNil(members.hosts),
+			Hostgroup: stringSliceOrNil(members.hostgroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.allowCommands)+len(members.allowCommandGroups) > 0 {
+		res, err := r.client.SudoruleRemoveAllowCommand(&freeipa.SudoruleRemoveAllowCommandArgs{Cn: name}, &freeipa.SudoruleRemoveAllowCommandOptionalArgs{
+			Sudocmd:      stringSliceOrNil(members.allowCommands),
+			Sudocmdgroup: stringSliceOrNil(members.allowCommandGroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.denyCommands)+len(members.denyCommandGroups) > 0 {
+		res, err := r.client.SudoruleRemoveDenyCommand(&freeipa.SudoruleRemoveDenyCommandArgs{Cn: name}, &freeipa.SudoruleRemoveDenyCommandOptionalArgs{
+			Sudocmd:      stringSliceOrNil(members.denyCommands),
+			Sudocmdgroup: stringSliceOrNil(members.denyCommandGroups),
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.runasUsers) > 0 {
+		res, err := r.client.SudoruleRemoveRunasuser(&freeipa.SudoruleRemoveRunasuserArgs{Cn: name}, &freeipa.SudoruleRemoveRunasuserOptionalArgs{
+			User: &members.runasUsers,
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+	if len(members.runasGroups) > 0 {
+		res, err := r.client.SudoruleRemoveRunasgroup(&freeipa.SudoruleRemoveRunasgroupArgs{Cn: name}, &freeipa.SudoruleRemoveRunasgroupOptionalArgs{
+			Group: &members.runasGroups,
+		})
+		if err != nil {
+			return err
+		}
+		failures = append(failures, res.Failed)
+	}
+
+	for _, failed := range failures {
+		if err := failedMembersError(failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateOptions adds and removes sudo options of a sudo rule. FreeIPA only
+// handles one option per call, and options are passed through verbatim so that
+// the stored strings match the configuration.
+func (r *FreeipaSudoRuleResource) updateOptions(name string, add, remove []string) error {
+	for _, option := range remove {
+		_, err := r.client.SudoruleRemoveOption(&freeipa.SudoruleRemoveOptionArgs{
+			Cn:         name,
+			Ipasudoopt: option,
+		}, &freeipa.SudoruleRemoveOptionOptionalArgs{})
+		if err != nil && !isFreeipaError(err, freeipaErrAttrValueNotFound) {
+			return fmt.Errorf("unable to remove option %q: %w", option, err)
+		}
+	}
+	for _, option := range add {
+		_, err := r.client.SudoruleAddOption(&freeipa.SudoruleAddOptionArgs{
+			Cn:         name,
+			Ipasudoopt: option,
+		}, &freeipa.SudoruleAddOptionOptionalArgs{})
+		if err != nil {
+			return fmt.Errorf("unable to add option %q: %w", option, err)
+		}
+	}
+	return nil
+}
+
+// setEnabled enables or disables a sudo rule.
+func (r *FreeipaSudoRuleResource) setEnabled(name string, enabled bool) error {
+	var err error
+	if enabled {
+		_, err = r.client.SudoruleEnable(&freeipa.SudoruleEnableArgs{Cn: name}, &freeipa.SudoruleEnableOptionalArgs{})
+		if isFreeipaError(err, freeipaErrAlreadyActive) {
+			return nil
+		}
+	} else {
+		_, err = r.client.SudoruleDisable(&freeipa.SudoruleDisableArgs{Cn: name}, &freeipa.SudoruleDisableOptionalArgs{})
+		if isFreeipaError(err, freeipaErrAlreadyInactive) {
+			return nil
+		}
+	}
+	return err
+}
+
+// showSudoRule retrieves a sudo rule entry.
+func (r *FreeipaSudoRuleResource) showSudoRule(name string) (*freeipa.Sudorule, error) {
+	rule, err := r.client.SudoruleShow(&freeipa.SudoruleShowArgs{Cn: name}, &freeipa.SudoruleShowOptionalArgs{})
+	if err != nil {
+		return nil, err
+	}
+	return &rule.Result, nil
+}
+
+func (r *FreeipaSudoRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaSudoRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, diags := data.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	options, diags := stringSetElements(ctx, data.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	optArgs := &freeipa.SudoruleAddOptionalArgs{}
+	if !data.Description.IsNull() {
+		optArgs.Description = utils.RefString(data.Description.ValueString())
+	}
+	if !data.SudoOrder.IsNull() {
+		optArgs.Sudoorder = utils.RefInt(int(data.SudoOrder.ValueInt64()))
+	}
+	if !data.UserCategory.IsNull() {
+		optArgs.Usercategory = utils.RefString(data.UserCategory.ValueString())
+	}
+	if !data.HostCategory.IsNull() {
+		optArgs.Hostcategory = utils.RefString(data.HostCategory.ValueString())
+	}
+	if !data.CmdCategory.IsNull() {
+		optArgs.Cmdcategory = utils.RefString(data.CmdCategory.ValueString())
+	}
+	if !data.RunasUserCategory.IsNull() {
+		optArgs.Ipasudorunasusercategory = utils.RefString(data.RunasUserCategory.ValueString())
+	}
+	if !data.RunasGroupCategory.IsNull() {
+		optArgs.Ipasudorunasgroupcategory = utils.RefString(data.RunasGroupCategory.ValueString())
+	}
+
+	_, err := r.client.SudoruleAdd(&freeipa.SudoruleAddArgs{Cn: name}, optArgs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sudo rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created sudo rule: %s", name))
+
+	if !data.Enabled.ValueBool() {
+		if err := r.setEnabled(name, false); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable sudo rule %s, got error: %s", data.Name.String(), err))
+			return
+		}
+	}
+
+	if err := r.updateOptions(name, options, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set options of sudo rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	if err := r.addMembers(name, members); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to sudo rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showSudoRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(data.setFromSudoRule(ctx, rule)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FreeipaSudoRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaSudoRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.showSudoRule(state.Name.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("sudo rule %s not found, removing it from the state", state.Name.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(state.setFromSudoRule(ctx, rule)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaSudoRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaSudoRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	want, diags := plan.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	have, diags := state.members(ctx)
+	resp.Diagnostics.Append(diags...)
+	wantOptions, diags := stringSetElements(ctx, plan.Options)
+	resp.Diagnostics.Append(diags...)
+	haveOptions, diags := stringSetElements(ctx, state.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := state.Name.ValueString()
+	add, remove := diffSudoRuleMembers(have, want)
+
+	// Members are removed before a category is set and added after it is
+	// cleared, since FreeIPA refuses members alongside a category.
+	if err := r.removeMembers(name, remove); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove members from sudo rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	optArgs := &freeipa.SudoruleModOptionalArgs{}
+	changed := false
+	attrs := []struct {
+		plan, state types.String
+		arg         **string
+	}{
+		{plan.Description, state.Description, &optArgs.Description},
+		{plan.UserCategory, state.UserCategory, &optArgs.Usercategory},
+		{plan.HostCategory, state.HostCategory, &optArgs.Hostcategory},
+		{plan.CmdCategory, state.CmdCategory, &optArgs.Cmdcategory},
+		{plan.RunasUserCategory, state.RunasUserCategory, &optArgs.Ipasudorunasusercategory},
+		{plan.RunasGroupCategory, state.RunasGroupCategory, &optArgs.Ipasudorunasgroupcategory},
+	}
+	for _, a := range attrs {
+		if !a.plan.Equal(a.state) {
+			*a.arg = utils.RefString(a.plan.ValueString())
+			changed = true
+		}
+	}
+	if !plan.SudoOrder.Equal(state.SudoOrder) {
+		if plan.SudoOrder.IsNull() {
+			optArgs.Setattr = &[]string{"sudoorder="}
+		} else {
+			optArgs.Sudoorder = utils.RefInt(int(plan.SudoOrder.ValueInt64()))
+		}
+		changed = true
+	}
+	if changed {
+		_, err := r.client.SudoruleMod(&freeipa.SudoruleModArgs{Cn: name}, optArgs)
+		if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sudo rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	if !plan.Enabled.Equal(state.Enabled) {
+		if err := r.setEnabled(name, plan.Enabled.ValueBool()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sudo rule %s, got error: %s", state.Name.String(), err))
+			return
+		}
+	}
+
+	addOptions, removeOptions := utils.Diff(haveOptions, wantOptions)
+	if err := r.updateOptions(name, addOptions, removeOptions); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update options of sudo rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	if err := r.addMembers(name, add); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add members to sudo rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+
+	rule, err := r.showSudoRule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sudo rule %s, got error: %s", state.Name.String(), err))
+		return
+	}
+	resp.Diagnostics.Append(plan.setFromSudoRule(ctx, rule)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaSudoRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaSudoRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.SudoruleDel(&freeipa.SudoruleDelArgs{
+		Cn: []string{data.Name.ValueString()},
+	}, &freeipa.SudoruleDelOptionalArgs{})
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sudo rule %s, got error: %s", data.Name.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaSudoRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaSudoRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaSudoRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "id", "tftestsudorule"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "sudo_order", "10"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "cmdcategory", "all"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "options.*", "!authenticate"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "groups.*", "tftestsudogroup"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "hostgroups.*", "tftestsudohostgroup"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_sudo_rule.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestsudorule",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaSudoRuleResourceConfigUpdate,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("freeipa_sudo_rule.test", "sudo_order"),
+					resource.TestCheckNoResourceAttr("freeipa_sudo_rule.test", "cmdcategory"),
+					resource.TestCheckResourceAttr("freeipa_sudo_rule.test", "options.#", "1"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "options.*", "env_keep+=SSH_AUTH_SOCK"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "runas_users.*", "admin"),
+					resource.TestCheckTypeSetElemAttr("freeipa_sudo_rule.test", "allow_commands.*", "/usr/bin/tftestsudorule"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFreeipaSudoRuleResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Options with surrounding whitespace
+			{
+				Config:      testAccFreeipaSudoRuleResourceConfigInvalidOption,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("must not be empty nor start or end with whitespace"),
+			},
+			// A category along with the members it replaces
+			{
+				Config:      testAccFreeipaSudoRuleResourceConfigCategoryConflict,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// A rule holding an order
+			{
+				Config: testAccFreeipaSudoRuleResourceConfigOrder,
+			},
+			// Another rule with the order already taken
+			{
+				Config:      testAccFreeipaSudoRuleResourceConfigDuplicateOrder,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Duplicate sudo order"),
+			},
+		},
+	})
+}
+
+const testAccFreeipaSudoRuleResourcePrerequisites = `
+resource "freeipa_group" "test" {
+  name = "tftestsudogroup"
+}
+
+resource "freeipa_hostgroup" "test" {
+  name = "tftestsudohostgroup"
+}
+
+resource "freeipa_sudo_command" "test" {
+  command = "/usr/bin/tftestsudorule"
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfig = testAccFreeipaSudoRuleResourcePrerequisites + `
+resource "freeipa_sudo_rule" "test" {
+  name        = "tftestsudorule"
+  description = "Terraform acceptance test"
+  sudo_order  = 10
+  cmdcategory = "all"
+  options     = ["!authenticate"]
+  groups      = [freeipa_group.test.name]
+  hostgroups  = [freeipa_hostgroup.test.name]
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfigUpdate = testAccFreeipaSudoRuleResourcePrerequisites + `
+resource "freeipa_sudo_rule" "test" {
+  name           = "tftestsudorule"
+  description    = "Terraform acceptance test"
+  enabled        = false
+  options        = ["env_keep+=SSH_AUTH_SOCK"]
+  groups         = [freeipa_group.test.name]
+  hostgroups     = [freeipa_hostgroup.test.name]
+  allow_commands = [freeipa_sudo_command.test.command]
+  runas_users    = ["admin"]
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfigOrder = `
+resource "freeipa_sudo_rule" "first" {
+  name       = "tftestsudoorder1"
+  sudo_order = 4242
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfigDuplicateOrder = testAccFreeipaSudoRuleResourceConfigOrder + `
+resource "freeipa_sudo_rule" "second" {
+  name       = "tftestsudoorder2"
+  sudo_order = 4242
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfigInvalidOption = `
+resource "freeipa_sudo_rule" "test" {
+  name    = "tftestsudooption"
+  options = [" !authenticate"]
+}
+`
+
+const testAccFreeipaSudoRuleResourceConfigCategoryConflict = `
+resource "freeipa_sudo_rule" "test" {
+  name           = "tftestsudoconflict"
+  cmdcategory    = "all"
+  allow_commands = ["/usr/bin/less"]
+}
+`
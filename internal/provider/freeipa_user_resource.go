@@ -0,0 +1,841 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FreeipaUserResource{}
+var _ resource.ResourceWithImportState = &FreeipaUserResource{}
+
+func NewFreeipaUserResource() resource.Resource {
+	return &FreeipaUserResource{}
+}
+
+type FreeipaUserResource struct {
+	client *freeipa.Client
+}
+
+type FreeipaUserResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Login                  types.String `tfsdk:"login"`
+	FirstName              types.String `tfsdk:"first_name"`
+	LastName               types.String `tfsdk:"last_name"`
+	FullName               types.String `tfsdk:"full_name"`
+	Email                  types.Set    `tfsdk:"email"`
+	LoginShell             types.String `tfsdk:"login_shell"`
+	HomeDirectory          types.String `tfsdk:"home_directory"`
+	UidNumber              types.Int64  `tfsdk:"uid_number"`
+	GidNumber              types.Int64  `tfsdk:"gid_number"`
+	Title                  types.String `tfsdk:"title"`
+	Manager                types.String `tfsdk:"manager"`
+	DepartmentNumber       types.Set    `tfsdk:"department_number"`
+	EmployeeNumber         types.String `tfsdk:"employee_number"`
+	EmployeeType           types.String `tfsdk:"employee_type"`
+	TelephoneNumbers       types.Set    `tfsdk:"telephone_numbers"`
+	SshPublicKeys          types.Set    `tfsdk:"ssh_public_keys"`
+	UserAuthTypes          types.Set    `tfsdk:"user_auth_types"`
+	KrbPrincipalExpiration types.String `tfsdk:"krb_principal_expiration"`
+	KrbPasswordExpiration  types.String `tfsdk:"krb_password_expiration"`
+	NoPrivate              types.Bool   `tfsdk:"noprivate"`
+	Password               types.String `tfsdk:"password"`
+	State                  types.String `tfsdk:"state"`
+	PreserveOnDestroy      types.Bool   `tfsdk:"preserve_on_destroy"`
+}
+
+// Lifecycle states of a FreeIPA user.
+const (
+	userStateStaged    = "staged"
+	userStateActive    = "active"
+	userStateDisabled  = "disabled"
+	userStatePreserved = "preserved"
+)
+
+// timestampValidator validates that a string is an RFC 3339 timestamp in the
+// form FreeIPA returns it, in UTC and without fractional seconds.
+type timestampValidator struct{}
+
+func (v timestampValidator) Description(ctx context.Context) string {
+	return "value must be an RFC 3339 timestamp in UTC without fractional seconds, such as 2030-01-01T00:00:00Z"
+}
+
+func (v timestampValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueString()
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil || t.UTC().Format(time.RFC3339) != value {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("Attribute %s %s, got: %s", req.Path, v.Description(ctx), value),
+		)
+	}
+}
+
+func (r *FreeipaUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (r *FreeipaUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Freeipa user resource",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "user identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"login": schema.StringAttribute{
+				MarkdownDescription: "Login of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"first_name": schema.StringAttribute{
+				MarkdownDescription: "First name of the user",
+				Required:            true,
+			},
+			"last_name": schema.StringAttribute{
+				MarkdownDescription: "Last name of the user",
+				Required:            true,
+			},
+			"full_name": schema.StringAttribute{
+				MarkdownDescription: "Full name (cn) of the user, defaults to first and last name",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email": schema.SetAttribute{
+				MarkdownDescription: "Email addresses of the user. When unset, the address FreeIPA derives from the login in the default email domain is left alone; removing the attribute clears the addresses",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"login_shell": schema.StringAttribute{
+				MarkdownDescription: "Login shell of the user, defaults to the server configured shell",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"home_directory": schema.StringAttribute{
+				MarkdownDescription: "Home directory of the user, defaults to the server configured base directory and the login",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"uid_number": schema.Int64Attribute{
+				MarkdownDescription: "User ID number, assigned by the server if not provided",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"gid_number": schema.Int64Attribute{
+				MarkdownDescription: "Group ID number, defaults to the user private group",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Job title of the user",
+				Optional:            true,
+			},
+			"manager": schema.StringAttribute{
+				MarkdownDescription: "Login of the user's manager",
+				Optional:            true,
+			},
+			"department_number": schema.SetAttribute{
+				MarkdownDescription: "Department numbers of the user",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"employee_number": schema.StringAttribute{
+				MarkdownDescription: "Employee number of the user",
+				Optional:            true,
+			},
+			"employee_type": schema.StringAttribute{
+				MarkdownDescription: "Employee type of the user",
+				Optional:            true,
+			},
+			"telephone_numbers": schema.SetAttribute{
+				MarkdownDescription: "Telephone numbers of the user",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"ssh_public_keys": schema.SetAttribute{
+				MarkdownDescription: "SSH public keys of the user",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"user_auth_types": schema.SetAttribute{
+				MarkdownDescription: "Authentication types allowed for the user, any of `password`, `radius`, `otp`, `pkinit`, `hardened`, `idp` or `passkey`",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("password", "radius", "otp", "pkinit", "hardened", "idp", "passkey"),
+					),
+				},
+			},
+			"krb_principal_expiration": schema.StringAttribute{
+				MarkdownDescription: "Kerberos principal expiration, as an RFC 3339 timestamp in UTC without fractional seconds, such as `2030-01-01T00:00:00Z`",
+				Optional:            true,
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"krb_password_expiration": schema.StringAttribute{
+				MarkdownDescription: "Password expiration, as an RFC 3339 timestamp in UTC without fractional seconds, such as `2030-01-01T00:00:00Z`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					timestampValidator{},
+				},
+			},
+			"noprivate": schema.BoolAttribute{
+				MarkdownDescription: "Do not create the user private group, ignored for staged users",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Initial password of the user. Only used when the user is created and never stored in the state, requires Terraform 1.11 or later",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Lifecycle state of the user, one of `staged`, `active`, `disabled` or `preserved`. Staged users are activated, and preserved users restored, when moved to another state. Defaults to `active`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(userStateActive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(userStateStaged, userStateActive, userStateDisabled, userStatePreserved),
+				},
+			},
+			"preserve_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Preserve the user instead of deleting it permanently when the resource is destroyed. Defaults to `false`",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *FreeipaUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*freeipaProviderData)
+
+	if !ok || data == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *freeipaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = data.client
+}
+
+// userTime converts a timestamp attribute, already checked by
+// timestampValidator, to a time.
+func userTime(value types.String) *time.Time {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// addArgs builds the user-add arguments from the planned model.
+func (data *FreeipaUserResourceModel) addArgs(ctx context.Context, password types.String, diags *diag.Diagnostics) *freeipa.UserAddOptionalArgs {
+	optArgs := &freeipa.UserAddOptionalArgs{
+		UID: utils.RefString(data.Login.ValueString()),
+	}
+	if !data.FullName.IsUnknown() && !data.FullName.IsNull() {
+		optArgs.Cn = utils.RefString(data.FullName.ValueString())
+	}
+	if !data.LoginShell.IsUnknown() && !data.LoginShell.IsNull() {
+		optArgs.Loginshell = utils.RefString(data.LoginShell.ValueString())
+	}
+	if !data.HomeDirectory.IsUnknown() && !data.HomeDirectory.IsNull() {
+		optArgs.Homedirectory = utils.RefString(data.HomeDirectory.ValueString())
+	}
+	if !data.UidNumber.IsUnknown() && !data.UidNumber.IsNull() {
+		optArgs.Uidnumber = utils.RefInt(int(data.UidNumber.ValueInt64()))
+	}
+	if !data.GidNumber.IsUnknown() && !data.GidNumber.IsNull() {
+		optArgs.Gidnumber = utils.RefInt(int(data.GidNumber.ValueInt64()))
+	}
+	if !data.Title.IsNull() {
+		optArgs.Title = utils.RefString(data.Title.ValueString())
+	}
+	if !data.Manager.IsNull() {
+		optArgs.Manager = utils.RefString(data.Manager.ValueString())
+	}
+	if !data.EmployeeNumber.IsNull() {
+		optArgs.Employeenumber = utils.RefString(data.EmployeeNumber.ValueString())
+	}
+	if !data.EmployeeType.IsNull() {
+		optArgs.Employeetype = utils.RefString(data.EmployeeType.ValueString())
+	}
+	if !data.NoPrivate.IsNull() {
+		optArgs.Noprivate = utils.RefBool(data.NoPrivate.ValueBool())
+	}
+	if !password.IsNull() {
+		optArgs.Userpassword = utils.RefString(password.ValueString())
+	}
+	optArgs.Krbprincipalexpiration = userTime(data.KrbPrincipalExpiration)
+	optArgs.Krbpasswordexpiration = userTime(data.KrbPasswordExpiration)
+
+	sets := []struct {
+		value types.Set
+		arg   **[]string
+	}{
+		{data.Email, &optArgs.Mail},
+		{data.DepartmentNumber, &optArgs.Departmentnumber},
+		{data.TelephoneNumbers, &optArgs.Telephonenumber},
+		{data.SshPublicKeys, &optArgs.Ipasshpubkey},
+		{data.UserAuthTypes, &optArgs.Ipauserauthtype},
+	}
+	for _, s := range sets {
+		if s.value.IsNull() || s.value.IsUnknown() {
+			continue
+		}
+		elems, d := stringSetElements(ctx, s.value)
+		diags.Append(d...)
+		*s.arg = &elems
+	}
+
+	return optArgs
+}
+
+// modArgs builds the user-mod arguments for the attributes that differ
+// between the plan and the prior state. It returns nil when nothing changed.
+func (plan *FreeipaUserResourceModel) modArgs(ctx context.Context, state *FreeipaUserResourceModel, diags *diag.Diagnostics) *freeipa.UserModOptionalArgs {
+	optArgs := &freeipa.UserModOptionalArgs{
+		UID: utils.RefString(state.Login.ValueString()),
+	}
+	changed := false
+
+	attrs := []struct {
+		plan, state types.String
+		arg         **string
+	}{
+		{plan.FirstName, state.FirstName, &optArgs.Givenname},
+		{plan.LastName, state.LastName, &optArgs.Sn},
+		{plan.FullName, state.FullName, &optArgs.Cn},
+		{plan.LoginShell, state.LoginShell, &optArgs.Loginshell},
+		{plan.HomeDirectory, state.HomeDirectory, &optArgs.Homedirectory},
+		{plan.Title, state.Title, &optArgs.Title},
+		{plan.Manager, state.Manager, &optArgs.Manager},
+		{plan.EmployeeNumber, state.EmployeeNumber, &optArgs.Employeenumber},
+		{plan.EmployeeType, state.EmployeeType, &optArgs.Employeetype},
+	}
+	for _, s := range attrs {
+		if s.plan.IsUnknown() || s.plan.Equal(s.state) {
+			continue
+		}
+		// An empty value removes the attribute from the entry
+		*s.arg = utils.RefString(s.plan.ValueString())
+		changed = true
+	}
+
+	if !plan.UidNumber.IsUnknown() && !plan.UidNumber.Equal(state.UidNumber) {
+		optArgs.Uidnumber = utils.RefInt(int(plan.UidNumber.ValueInt64()))
+		changed = true
+	}
+	if !plan.GidNumber.IsUnknown() && !plan.GidNumber.Equal(state.GidNumber) {
+		optArgs.Gidnumber = utils.RefInt(int(plan.GidNumber.ValueInt64()))
+		changed = true
+	}
+
+	sets := []struct {
+		plan, state types.Set
+		arg         **[]string
+	}{
+		{plan.Email, state.Email, &optArgs.Mail},
+		{plan.DepartmentNumber, state.DepartmentNumber, &optArgs.Departmentnumber},
+		{plan.TelephoneNumbers, state.TelephoneNumbers, &optArgs.Telephonenumber},
+		{plan.SshPublicKeys, state.SshPublicKeys, &optArgs.Ipasshpubkey},
+		{plan.UserAuthTypes, state.UserAuthTypes, &optArgs.Ipauserauthtype},
+	}
+	for _, s := range sets {
+		if s.plan.IsUnknown() || s.plan.Equal(s.state) {
+			continue
+		}
+		elems, d := stringSetElements(ctx, s.plan)
+		diags.Append(d...)
+		*s.arg = &elems
+		changed = true
+	}
+
+	if !plan.KrbPrincipalExpiration.Equal(state.KrbPrincipalExpiration) {
+		if plan.KrbPrincipalExpiration.IsNull() {
+			// Removing the attribute requires an explicit delattr
+			optArgs.Delattr = &[]string{"krbprincipalexpiration="}
+		} else {
+			optArgs.Krbprincipalexpiration = userTime(plan.KrbPrincipalExpiration)
+		}
+		changed = true
+	}
+	if !plan.KrbPasswordExpiration.IsUnknown() && !plan.KrbPasswordExpiration.IsNull() && !plan.KrbPasswordExpiration.Equal(state.KrbPasswordExpiration) {
+		optArgs.Krbpasswordexpiration = userTime(plan.KrbPasswordExpiration)
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return optArgs
+}
+
+// stageuserAddArgs converts user-add arguments to their stageuser-add
+// counterpart. Options that only apply to active users are dropped.
+func stageuserAddArgs(u *freeipa.UserAddOptionalArgs) *freeipa.StageuserAddOptionalArgs {
+	return &freeipa.StageuserAddOptionalArgs{
+		UID:                    u.UID,
+		Cn:                     u.Cn,
+		Homedirectory:          u.Homedirectory,
+		Loginshell:             u.Loginshell,
+		Krbprincipalexpiration: u.Krbprincipalexpiration,
+		Krbpasswordexpiration:  u.Krbpasswordexpiration,
+		Mail:                   u.Mail,
+		Userpassword:           u.Userpassword,
+		Uidnumber:              u.Uidnumber,
+		Gidnumber:              u.Gidnumber,
+		Telephonenumber:        u.Telephonenumber,
+		Title:                  u.Title,
+		Manager:                u.Manager,
+		Ipasshpubkey:           u.Ipasshpubkey,
+		Ipauserauthtype:        u.Ipauserauthtype,
+		Departmentnumber:       u.Departmentnumber,
+		Employeenumber:         u.Employeenumber,
+		Employeetype:           u.Employeetype,
+	}
+}
+
+// stageuserModArgs converts user-mod arguments to their stageuser-mod
+// counterpart.
+func stageuserModArgs(u *freeipa.UserModOptionalArgs) *freeipa.StageuserModOptionalArgs {
+	return &freeipa.StageuserModOptionalArgs{
+		UID:                    u.UID,
+		Givenname:              u.Givenname,
+		Sn:                     u.Sn,
+		Cn:                     u.Cn,
+		Homedirectory:          u.Homedirectory,
+		Loginshell:             u.Loginshell,
+		Krbprincipalexpiration: u.Krbprincipalexpiration,
+		Krbpasswordexpiration:  u.Krbpasswordexpiration,
+		Mail:                   u.Mail,
+		Uidnumber:              u.Uidnumber,
+		Gidnumber:              u.Gidnumber,
+		Telephonenumber:        u.Telephonenumber,
+		Title:                  u.Title,
+		Manager:                u.Manager,
+		Ipasshpubkey:           u.Ipasshpubkey,
+		Ipauserauthtype:        u.Ipauserauthtype,
+		Departmentnumber:       u.Departmentnumber,
+		Employeenumber:         u.Employeenumber,
+		Employeetype:           u.Employeetype,
+		Delattr:                u.Delattr,
+	}
+}
+
+// userFromStageuser converts a staged user entry to a user entry so that it
+// can be stored in the model like any other user.
+func userFromStageuser(s *freeipa.Stageuser) *freeipa.User {
+	return &freeipa.User{
+		UID:                    s.UID,
+		Givenname:              utils.RefString(s.Givenname),
+		Sn:                     s.Sn,
+		Cn:                     utils.RefString(s.Cn),
+		Homedirectory:          s.Homedirectory,
+		Loginshell:             s.Loginshell,
+		Krbprincipalexpiration: s.Krbprincipalexpiration,
+		Krbpasswordexpiration:  s.Krbpasswordexpiration,
+		Mail:                   s.Mail,
+		Uidnumber:              s.Uidnumber,
+		Gidnumber:              s.Gidnumber,
+		Telephonenumber:        s.Telephonenumber,
+		Title:                  s.Title,
+		Manager:                s.Manager,
+		Ipasshpubkey:           s.Ipasshpubkey,
+		Ipauserauthtype:        s.Ipauserauthtype,
+		Departmentnumber:       s.Departmentnumber,
+		Employeenumber:         s.Employeenumber,
+		Employeetype:           s.Employeetype,
+	}
+}
+
+// showUser retrieves the full entry of a user in any lifecycle state, along
+// with that state.
+func (r *FreeipaUserResource) showUser(login string) (*freeipa.User, string, error) {
+	user, err := r.client.UserShow(&freeipa.UserShowArgs{}, &freeipa.UserShowOptionalArgs{
+		UID: utils.RefString(login),
+		All: utils.RefBool(true),
+	})
+	if err == nil {
+		switch {
+		case user.Result.Preserved != nil && *user.Result.Preserved:
+			return &user.Result, userStatePreserved, nil
+		case user.Result.Nsaccountlock != nil && *user.Result.Nsaccountlock:
+			return &user.Result, userStateDisabled, nil
+		default:
+			return &user.Result, userStateActive, nil
+		}
+	}
+	if !isNotFound(err) {
+		return nil, "", err
+	}
+
+	stageuser, err := r.client.StageuserShow(&freeipa.StageuserShowArgs{}, &freeipa.StageuserShowOptionalArgs{
+		UID: utils.RefString(login),
+		All: utils.RefBool(true),
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return userFromStageuser(&stageuser.Result), userStateStaged, nil
+}
+
+// modifyUser applies attribute changes to a user in the given state.
+func (r *FreeipaUserResource) modifyUser(optArgs *freeipa.UserModOptionalArgs, state string) error {
+	var err error
+	switch state {
+	case userStateStaged:
+		_, err = r.client.StageuserMod(&freeipa.StageuserModArgs{}, stageuserModArgs(optArgs))
+	case userStatePreserved:
+		return fmt.Errorf("preserved users cannot be modified, restore the user first")
+	default:
+		_, err = r.client.UserMod(&freeipa.UserModArgs{}, optArgs)
+	}
+	if err != nil && !isFreeipaError(err, freeipaErrEmptyModlist) {
+		return err
+	}
+	return nil
+}
+
+// transitionUser moves a user from one lifecycle state to another.
+func (r *FreeipaUserResource) transitionUser(ctx context.Context, login, from, to string) error {
+	for from != to {
+		tflog.Debug(ctx, fmt.Sprintf("moving user %s from %s to %s", login, from, to))
+
+		var err error
+		switch {
+		case from == userStateStaged:
+			_, err = r.client.StageuserActivate(&freeipa.StageuserActivateArgs{}, &freeipa.StageuserActivateOptionalArgs{
+				UID: utils.RefString(login),
+			})
+			from = userStateActive
+		case to == userStateStaged && from == userStatePreserved:
+			_, err = r.client.UserStage(&freeipa.UserStageArgs{}, &freeipa.UserStageOptionalArgs{
+				UID: &[]string{login},
+			})
+			from = userStateStaged
+		case to == userStateStaged || to == userStatePreserved:
+			// Only preserved users can be moved back to the staging area
+			_, err = r.client.UserDel(&freeipa.UserDelArgs{}, &freeipa.UserDelOptionalArgs{
+				UID:      &[]string{login},
+				Preserve: utils.RefBool(true),
+			})
+			from = userStatePreserved
+		case from == userStatePreserved:
+			_, err = r.client.UserUndel(&freeipa.UserUndelArgs{}, &freeipa.UserUndelOptionalArgs{
+				UID: utils.RefString(login),
+			})
+			// The lock state of a restored account is unknown, settle it explicitly
+			from = ""
+		case to == userStateDisabled:
+			_, err = r.client.UserDisable(&freeipa.UserDisableArgs{}, &freeipa.UserDisableOptionalArgs{
+				UID: utils.RefString(login),
+			})
+			if isFreeipaError(err, freeipaErrAlreadyInactive) {
+				err = nil
+			}
+			from = userStateDisabled
+		case to == userStateActive:
+			_, err = r.client.UserEnable(&freeipa.UserEnableArgs{}, &freeipa.UserEnableOptionalArgs{
+				UID: utils.RefString(login),
+			})
+			if isFreeipaError(err, freeipaErrAlreadyActive) {
+				err = nil
+			}
+			from = userStateActive
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *FreeipaUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FreeipaUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	// The password is write-only and is therefore only present in the configuration
+	var password types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("password"), &password)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	optArgs := data.addArgs(ctx, password, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	if data.State.ValueString() == userStateStaged {
+		_, err = r.client.StageuserAdd(&freeipa.StageuserAddArgs{
+			Givenname: data.FirstName.ValueString(),
+			Sn:        data.LastName.ValueString(),
+		}, stageuserAddArgs(optArgs))
+	} else {
+		if data.State.ValueString() == userStateDisabled {
+			optArgs.Nsaccountlock = utils.RefBool(true)
+		}
+		_, err = r.client.UserAdd(&freeipa.UserAddArgs{
+			Givenname: data.FirstName.ValueString(),
+			Sn:        data.LastName.ValueString(),
+		}, optArgs)
+		if err == nil && data.State.ValueString() == userStatePreserved {
+			err = r.transitionUser(ctx, data.Login.ValueString(), userStateActive, userStatePreserved)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user %s, got error: %s", data.Login.String(), err))
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created user: %s", data.Login.ValueString()))
+
+	user, state, err := r.showUser(data.Login.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", data.Login.String(), err))
+		return
+	}
+	data.setFromUser(ctx, user, &resp.Diagnostics)
+	data.State = types.StringValue(state)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// setFromUser copies the attributes of a FreeIPA user entry into the model.
+// The email addresses are only set when managed, or on the read following an
+// import, which leaves the identifier unset.
+func (data *FreeipaUserResourceModel) setFromUser(ctx context.Context, user *freeipa.User, diags *diag.Diagnostics) {
+	var d diag.Diagnostics
+
+	if !data.Email.IsNull() || data.Id.IsNull() {
+		data.Email, d = stringSetValueOrNull(ctx, user.Mail)
+		diags.Append(d...)
+	}
+	data.Id = types.StringValue(user.UID)
+	data.Login = types.StringValue(user.UID)
+	data.FirstName = stringValueOrNull(user.Givenname)
+	data.LastName = types.StringValue(user.Sn)
+	data.FullName = stringValueOrNull(user.Cn)
+	data.LoginShell = stringValueOrNull(user.Loginshell)
+	data.HomeDirectory = stringValueOrNull(user.Homedirectory)
+	data.UidNumber = int64ValueOrNull(user.Uidnumber)
+	data.GidNumber = int64ValueOrNull(user.Gidnumber)
+	data.Title = stringValueOrNull(user.Title)
+	data.Manager = stringValueOrNull(user.Manager)
+	data.EmployeeNumber = stringValueOrNull(user.Employeenumber)
+	data.EmployeeType = stringValueOrNull(user.Employeetype)
+	data.KrbPrincipalExpiration = timeValueOrNull(user.Krbprincipalexpiration)
+	data.KrbPasswordExpiration = timeValueOrNull(user.Krbpasswordexpiration)
+
+	data.DepartmentNumber, d = stringSetValueOrNull(ctx, user.Departmentnumber)
+	diags.Append(d...)
+	data.TelephoneNumbers, d = stringSetValueOrNull(ctx, user.Telephonenumber)
+	diags.Append(d...)
+	data.SshPublicKeys, d = stringSetValueOrNull(ctx, user.Ipasshpubkey)
+	diags.Append(d...)
+	data.UserAuthTypes, d = stringSetValueOrNull(ctx, user.Ipauserauthtype)
+	diags.Append(d...)
+}
+
+func (r *FreeipaUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state FreeipaUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, userState, err := r.showUser(state.Login.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("user %s not found, removing it from the state", state.Login.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", state.Login.String(), err))
+		return
+	}
+
+	state.setFromUser(ctx, user, &resp.Diagnostics)
+	state.State = types.StringValue(userState)
+	if state.PreserveOnDestroy.IsNull() {
+		// Not set after an import
+		state.PreserveOnDestroy = types.BoolValue(false)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *FreeipaUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state FreeipaUserResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	login := state.Login.ValueString()
+	from, to := state.State.ValueString(), plan.State.ValueString()
+
+	optArgs := plan.modArgs(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Preserved users cannot be modified, so changes are applied before
+	// preserving a user and after restoring it.
+	var err error
+	if to == userStatePreserved {
+		if optArgs != nil {
+			err = r.modifyUser(optArgs, from)
+		}
+		if err == nil {
+			err = r.transitionUser(ctx, login, from, to)
+		}
+	} else {
+		err = r.transitionUser(ctx, login, from, to)
+		if err == nil && optArgs != nil {
+			err = r.modifyUser(optArgs, to)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user %s, got error: %s", state.Login.String(), err))
+		return
+	}
+
+	user, userState, err := r.showUser(login)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user %s, got error: %s", state.Login.String(), err))
+		return
+	}
+	plan.setFromUser(ctx, user, &resp.Diagnostics)
+	plan.State = types.StringValue(userState)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FreeipaUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FreeipaUserResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	login := data.Login.ValueString()
+	preserve := data.PreserveOnDestroy.ValueBool()
+
+	var err error
+	switch data.State.ValueString() {
+	case userStateStaged:
+		_, err = r.client.StageuserDel(&freeipa.StageuserDelArgs{}, &freeipa.StageuserDelOptionalArgs{
+			UID: &[]string{login},
+		})
+	case userStatePreserved:
+		if preserve {
+			tflog.Trace(ctx, fmt.Sprintf("keeping preserved user: %s", login))
+			return
+		}
+		_, err = r.client.UserDel(&freeipa.UserDelArgs{}, &freeipa.UserDelOptionalArgs{
+			UID: &[]string{login},
+		})
+	default:
+		_, err = r.client.UserDel(&freeipa.UserDelArgs{}, &freeipa.UserDelOptionalArgs{
+			UID:      &[]string{login},
+			Preserve: utils.RefBool(preserve),
+		})
+	}
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user %s, got error: %s", data.Login.String(), err))
+		return
+	}
+}
+
+func (r *FreeipaUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("login"), req, resp)
+}
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFreeipaUserResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccFreeipaUserResourceConfig("Engineer", `email = ["tftestuser@corp.example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user.test", "id", "tftestuser"),
+					resource.TestCheckResourceAttr("freeipa_user.test", "login", "tftestuser"),
+					resource.TestCheckResourceAttr("freeipa_user.test", "full_name", "Terraform Test"),
+					resource.TestCheckResourceAttr("freeipa_user.test", "title", "Engineer"),
+					resource.TestCheckResourceAttr("freeipa_user.test", "login_shell", "/bin/bash"),
+					resource.TestCheckTypeSetElemAttr("freeipa_user.test", "email.*", "tftestuser@corp.example.com"),
+					resource.TestCheckResourceAttr("freeipa_user.test", "krb_principal_expiration", "2030-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet("freeipa_user.test", "uid_number"),
+					resource.TestCheckNoResourceAttr("freeipa_user.test", "password"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "freeipa_user.test",
+				ImportState:                          true,
+				ImportStateId:                        "tftestuser",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "login",
+				ImportStateVerifyIgnore:              []string{"password"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccFreeipaUserResourceConfig("Manager", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("freeipa_user.test", "title", "Manager"),
+					resource.TestCheckNoResourceAttr("freeipa_user.test", "email.#"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccFreeipaUserResource_lifecycle(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFreeipaUserResourceLifecycleConfig("staged"),
+				Check:  resource.TestCheckResourceAttr("freeipa_user.test", "state", "staged"),
+			},
+			{
+				Config: testAccFreeipaUserResourceLifecycleConfig("active"),
+				Check:  resource.TestCheckResourceAttr("freeipa_user.test", "state", "active"),
+			},
+			{
+				Config: testAccFreeipaUserResourceLifecycleConfig("disabled"),
+				Check:  resource.TestCheckResourceAttr("freeipa_user.test", "state", "disabled"),
+			},
+			{
+				Config: testAccFreeipaUserResourceLifecycleConfig("preserved"),
+				Check:  resource.TestCheckResourceAttr("freeipa_user.test", "state", "preserved"),
+			},
+			{
+				Config: testAccFreeipaUserResourceLifecycleConfig("active"),
+				Check:  resource.TestCheckResourceAttr("freeipa_user.test", "state", "active"),
+			},
+		},
+	})
+}
+
+func TestAccFreeipaUserResource_validation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV5ProviderFactories: testAccProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			// Timestamp with an offset
+			{
+				Config:      testAccFreeipaUserResourceConfigExpiration("2030-01-01T01:00:00+01:00"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Timestamp"),
+			},
+			// Timestamp with fractional seconds
+			{
+				Config:      testAccFreeipaUserResourceConfigExpiration("2030-01-01T00:00:00.5Z"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Invalid Timestamp"),
+			},
+		},
+	})
+}
+
+func testAccFreeipaUserResourceConfigExpiration(expiration string) string {
+	return fmt.Sprintf(`
+resource "freeipa_user" "test" {
+  login                   = "tftestexpiration"
+  first_name              = "Terraform"
+  last_name               = "Expiration"
+  krb_password_expiration = %[1]q
+}
+`, expiration)
+}
+
+func testAccFreeipaUserResourceLifecycleConfig(state string) string {
+	return fmt.Sprintf(`
+resource "freeipa_user" "test" {
+  login      = "tftestonboard"
+  first_name = "Terraform"
+  last_name  = "Onboarding"
+  state      = %[1]q
+}
+`, state)
+}
+
+func testAccFreeipaUserResourceConfig(title, email string) string {
+	return fmt.Sprintf(`
+resource "freeipa_user" "test" {
+  login                    = "tftestuser"
+  first_name               = "Terraform"
+  last_name                = "Test"
+  login_shell              = "/bin/bash"
+  title                    = %[1]q
+  password                 = "Secret123!"
+  krb_principal_expiration = "2030-01-01T00:00:00Z"
+  %[2]s
+}
+`, title, email)
+}
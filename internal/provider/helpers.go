@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// FreeIPA error codes, see ipalib/errors.py.
+const (
+	freeipaErrNotFound          = 4001
+	freeipaErrAlreadyInactive   = 4009
+	freeipaErrAlreadyActive     = 4010
+	freeipaErrAttrValueNotFound = 4026
+	freeipaErrEmptyModlist      = 4202
+)
+
+// Reasons reported by FreeIPA for member operations that partially failed.
+const (
+	freeipaReasonNotAMember = "This entry is not a member"
+)
+
+// isFreeipaError reports whether err is a FreeIPA error with the given code.
+func isFreeipaError(err error, code int) bool {
+	var ipaErr *freeipa.Error
+	if errors.As(err, &ipaErr) {
+		return ipaErr.Code == code
+	}
+	return false
+}
+
+// isNotFound reports whether err means the requested entry does not exist.
+func isNotFound(err error) bool {
+	return isFreeipaError(err, freeipaErrNotFound)
+}
+
+// stringValueOrNull converts an optional FreeIPA string to a Terraform string.
+func stringValueOrNull(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*s)
+}
+
+// int64ValueOrNull converts an optional FreeIPA integer to a Terraform number.
+func int64ValueOrNull(i *int) types.Int64 {
+	if i == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*i))
+}
+
+// timeValueOrNull converts an optional FreeIPA timestamp to an RFC 3339 string.
+func timeValueOrNull(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// stringSetValueOrNull converts an optional FreeIPA multi-valued attribute to a
+// Terraform set of strings.
+func stringSetValueOrNull(ctx context.Context, s *[]string) (types.Set, diag.Diagnostics) {
+	if s == nil || len(*s) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, *s)
+}
+
+// stringSetElements returns the elements of a Terraform set of strings. Null
+// and unknown sets yield an empty slice.
+func stringSetElements(ctx context.Context, s types.Set) ([]string, diag.Diagnostics) {
+	elems := []string{}
+	if s.IsNull() || s.IsUnknown() {
+		return elems, nil
+	}
+	diags := s.ElementsAs(ctx, &elems, false)
+	return elems, diags
+}
+
+// membersSetValue converts the members of an entry to a Terraform set of
+// strings. An empty prior set is kept empty rather than null so that an
+// explicitly empty member list does not show a perpetual diff.
+func membersSetValue(ctx context.Context, prior types.Set, members *[]string) (types.Set, diag.Diagnostics) {
+	if (members == nil || len(*members) == 0) && !prior.IsNull() && !prior.IsUnknown() {
+		return types.SetValueMust(types.StringType, []attr.Value{}), nil
+	}
+	return stringSetValueOrNull(ctx, members)
+}
+
+// matchAttributes returns the LDAP attribute names returned by FreeIPA,
+// spelled as in known for the attributes it holds, as attribute names are
+// case-insensitive.
+func matchAttributes(attributes, known []string) []string {
+	values := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		for _, k := range known {
+			if strings.EqualFold(k, attribute) {
+				attribute = k
+				break
+			}
+		}
+		values = append(values, attribute)
+	}
+	return values
+}
+
+// failedMembersError turns the partial failures reported by a member
+// operation into an error. Failures with one of the ignored reasons, such as
+// adding an entry that is already a member, are not reported.
+func failedMembersError(failed freeipa.FailedOperations, ignoredReasons ...string) error {
+	var failures []string
+	for category, ops := range failed.GetFailures() {
+		for _, op := range ops {
+			if slices.Contains(ignoredReasons, op.Reason) {
+				continue
+			}
+			failures = append(failures, fmt.Sprintf("%s %s: %s", category, op.Name, op.Reason))
+		}
+	}
+	if len(failures) == 0 {
+		return nil
+	}
+	sort.Strings(failures)
+	return fmt.Errorf("%s", strings.Join(failures, "; "))
+}
+
+// stringSliceOrNil returns a pointer to s, or nil when s is empty so that the
+// option is left out of the request.
+func stringSliceOrNil(s []string) *[]string {
+	if len(s) == 0 {
+		return nil
+	}
+	return &s
+}
+
+// categoryValidators validates the category attribute of a rule, such as the
+// user category of an HBAC rule. A category of `all` replaces the member sets
+// it conflicts with.
+func categoryValidators(members ...string) []validator.String {
+	expressions := make([]path.Expression, 0, len(members))
+	for _, member := range members {
+		expressions = append(expressions, path.MatchRoot(member))
+	}
+	return []validator.String{
+		stringvalidator.OneOf("all"),
+		stringvalidator.ConflictsWith(expressions...),
+	}
+}
@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-freeipa/internal/utils"
+)
+
+// entryMembers holds the members of an entry by member type, keyed by the
+// option of the commands adding and removing them, such as `user` or `host`.
+type entryMembers map[string][]string
+
+// memberCommands describes the FreeIPA commands managing a kind of members of
+// an object, such as the members or the member managers of a hostgroup.
+type memberCommands struct {
+	show, add, remove string
+	// attributes maps the options of the add and remove commands to the
+	// attributes holding the members in the shown entry.
+	attributes map[string]string
+}
+
+// showMembers retrieves the direct members of an entry.
+func (c memberCommands) showMembers(rpc *rpcClient, name string) (entryMembers, error) {
+	var res rpcEntryResult
+	if err := rpc.call(c.show, []interface{}{name}, nil, &res); err != nil {
+		return nil, err
+	}
+	return c.membersOf(res.Result), nil
+}
+
+// membersOf returns the direct members of an entry returned by FreeIPA.
+func (c memberCommands) membersOf(entry rpcEntry) entryMembers {
+	members := entryMembers{}
+	for option, attribute := range c.attributes {
+		members[option] = entry.values(attribute)
+	}
+	return members
+}
+
+// options returns the options adding or removing the members, leaving out
+// the member types without members.
+func (m entryMembers) options() map[string]interface{} {
+	options := map[string]interface{}{}
+	for option, members := range m {
+		if len(members) > 0 {
+			options[option] = members
+		}
+	}
+	return options
+}
+
+// addMembers adds members to an entry. Members that already belong to the
+// entry are not reported as failures.
+func (c memberCommands) addMembers(rpc *rpcClient, name string, members entryMembers) error {
+	options := members.options()
+	if len(options) == 0 {
+		return nil
+	}
+
+	var res rpcEntryResult
+	if err := rpc.call(c.add, []interface{}{name}, options, &res); err != nil {
+		return err
+	}
+	return failedMembersError(res.Failed, freeipa.FailedReasonAlreadyAMember)
+}
+
+// removeMembers removes members from an entry. Entries that are not members
+// are not reported as failures.
+func (c memberCommands) removeMembers(rpc *rpcClient, name string, members entryMembers) error {
+	options := members.options()
+	if len(options) == 0 {
+		return nil
+	}
+
+	var res rpcEntryResult
+	if err := rpc.call(c.remove, []interface{}{name}, options, &res); err != nil {
+		return err
+	}
+	return failedMembersError(res.Failed, freeipaReasonNotAMember, freeipa.FailedReasonNoSuchEntry)
+}
+
+// updateMembers removes the members in have but not in want from an entry,
+// then adds the members in want but not in have.
+func (c memberCommands) updateMembers(rpc *rpcClient, name string, have, want entryMembers) error {
+	add, remove := entryMembers{}, entryMembers{}
+	for option := range c.attributes {
+		add[option], remove[option] = utils.Diff(have[option], want[option])
+	}
+
+	if err := c.removeMembers(rpc, name, remove); err != nil {
+		return err
+	}
+	return c.addMembers(rpc, name, add)
+}
+
+// membersFromSets returns the members declared in the sets of a model, keyed
+// by member type.
+func membersFromSets(ctx context.Context, sets map[string]*types.Set) (entryMembers, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	members := entryMembers{}
+	for option, set := range sets {
+		elems, d := stringSetElements(ctx, *set)
+		diags.Append(d...)
+		members[option] = elems
+	}
+	return members, diags
+}
+
+// setMembersSets stores the members of an entry in the sets of a model, keyed
+// by member type.
+func setMembersSets(ctx context.Context, sets map[string]*types.Set, members entryMembers) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for option, set := range sets {
+		values := members[option]
+		v, d := membersSetValue(ctx, *set, &values)
+		diags.Append(d...)
+		*set = v
+	}
+	return diags
+}
@@ -35,6 +35,14 @@ type freeipaProviderModel struct {
 	Insecure types.Bool   `tfsdk:"insecure"`
 }
 
+// freeipaProviderData is handed to resources and data sources as their
+// provider data. It holds the go-freeipa client and the raw JSON-RPC client
+// used for the objects go-freeipa cannot handle.
+type freeipaProviderData struct {
+	client *freeipa.Client
+	rpc    *rpcClient
+}
+
 func (p *freeipaProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "freeipa"
 	resp.Version = p.version
@@ -174,25 +182,69 @@ func (p *freeipaProvider) Configure(ctx context.Context, req provider.ConfigureR
 			"login failed: %s"+err.Error())
 		return
 	}
-
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	rpc, err := newRPCClient(host, tspt, username, password)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create FreeIPA client", err.Error())
+		return
+	}
+	data := &freeipaProviderData{
+		client: client,
+		rpc:    rpc,
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
 }
 
 func (p *freeipaProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewFreeipaHostResource,
+		NewFreeipaUserResource,
+		NewFreeipaGroupResource,
+		NewFreeipaGroupMembershipResource,
+		NewFreeipaGroupMemberResource,
+		NewFreeipaHostgroupResource,
+		NewFreeipaHostgroupMembershipResource,
+		NewFreeipaHostgroupMemberResource,
+		NewFreeipaHbacRuleResource,
+		NewFreeipaHbacServiceResource,
+		NewFreeipaHbacServicegroupResource,
+		NewFreeipaSudoRuleResource,
+		NewFreeipaSudoCommandResource,
+		NewFreeipaSudoCommandGroupResource,
+		NewFreeipaRoleResource,
+		NewFreeipaPrivilegeResource,
+		NewFreeipaPermissionResource,
+		NewFreeipaSelfserviceResource,
+		NewFreeipaDelegationResource,
+		NewFreeipaServiceResource,
+		NewFreeipaServicedelegationRuleResource,
+		NewFreeipaServicedelegationTargetResource,
+		NewFreeipaDnsZoneResource,
+		NewFreeipaDnsRecordResource,
+		NewFreeipaDnsForwardZoneResource,
+		NewFreeipaDnsConfigResource,
+		NewFreeipaDnsServerResource,
+		NewFreeipaDnsZonePermissionResource,
+		NewFreeipaLocationResource,
+		NewFreeipaServerLocationResource,
 	}
 }
 
 func (p *freeipaProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewFreeipaHostDataSource,
+		NewFreeipaHbacTestDataSource,
+		NewFreeipaDnsRecordDataSource,
+		NewFreeipaDnsRecordsDataSource,
+		NewFreeipaDnsZoneDataSource,
 	}
 }
 
 func (p *freeipaProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewFreeipaReverseZoneFunction,
+		NewFreeipaReverseRecordNameFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,294 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ccin2p3/go-freeipa/freeipa"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// rpcAPIVersion is the API version sent along with raw requests, the same as
+// the one go-freeipa is generated from.
+const rpcAPIVersion = "2.237"
+
+// rpcClient sends FreeIPA JSON-RPC requests and decodes the responses without
+// go-freeipa's generated types. go-freeipa v1.2.0 fails to unmarshal the
+// entries of some objects, such as services without a certificate, so the
+// resources managing them go through this client instead. go-freeipa does not
+// expose its session, so this client opens its own on first use.
+type rpcClient struct {
+	host     string
+	username string
+	password string
+	hc       *http.Client
+
+	// mu guards the login, requests run concurrently.
+	mu sync.Mutex
+	// generation counts the sessions opened, zero before the first login.
+	generation int
+}
+
+func newRPCClient(host string, tspt *http.Transport, username, password string) (*rpcClient, error) {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, err
+	}
+	return &rpcClient{
+		host:     host,
+		username: username,
+		password: password,
+		hc: &http.Client{
+			Transport: tspt,
+			Jar:       jar,
+		},
+	}, nil
+}
+
+type rpcRequest struct {
+	Method string        `json:"method"`
+	Params []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *freeipa.Error  `json:"error"`
+}
+
+// rpcEntry is an entry returned by FreeIPA, keyed by attribute name.
+type rpcEntry map[string]interface{}
+
+// rpcEntryResult is the result of the commands returning a single entry.
+type rpcEntryResult struct {
+	Result rpcEntry                 `json:"result"`
+	Failed freeipa.FailedOperations `json:"failed"`
+}
+
+// rpcFindResult is the result of the *_find commands.
+type rpcFindResult struct {
+	Result    []rpcEntry `json:"result"`
+	Count     int        `json:"count"`
+	Truncated bool       `json:"truncated"`
+}
+
+func (c *rpcClient) login() error {
+	res, err := c.hc.PostForm(fmt.Sprintf("https://%s/ipa/session/login_password", c.host), url.Values{
+		"user":     []string{c.username},
+		"password": []string{c.password},
+	})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("login failed: unexpected http status code: %d", res.StatusCode)
+	}
+	return nil
+}
+
+// session returns the generation of the current session, logging in when no
+// session newer than stale is open. Concurrent calls renewing the same
+// expired session log in once.
+func (c *rpcClient) session(stale int) (int, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generation > stale {
+		return c.generation, nil
+	}
+	if err := c.login(); err != nil {
+		return 0, err
+	}
+	c.generation++
+	return c.generation, nil
+}
+
+func (c *rpcClient) send(body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("https://%s/ipa/session/json", c.host), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Referer", fmt.Sprintf("https://%s/ipa/ui", c.host))
+	return c.hc.Do(req)
+}
+
+// call runs a FreeIPA command and decodes its result into out. Errors reported
+// by FreeIPA are returned as *freeipa.Error.
+func (c *rpcClient) call(method string, args []interface{}, options map[string]interface{}, out interface{}) error {
+	if args == nil {
+		args = []interface{}{}
+	}
+	opts := map[string]interface{}{"version": rpcAPIVersion}
+	for k, v := range options {
+		opts[k] = v
+	}
+	body, err := json.Marshal(rpcRequest{Method: method, Params: []interface{}{args, opts}})
+	if err != nil {
+		return err
+	}
+
+	generation, err := c.session(0)
+	if err != nil {
+		return err
+	}
+	res, err := c.send(body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusUnauthorized {
+		res.Body.Close()
+		if _, err := c.session(generation); err != nil {
+			return fmt.Errorf("renewed %w", err)
+		}
+		if res, err = c.send(body); err != nil {
+			return err
+		}
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected http status code: %d", res.StatusCode)
+	}
+
+	var resp rpcResponse
+	if err := json.NewDecoder(res.Body).Decode(&resp); err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return resp.Error
+	}
+	if out == nil {
+		return nil
+	}
+	if len(resp.Result) == 0 {
+		return fmt.Errorf("missing result in response")
+	}
+	return json.Unmarshal(resp.Result, out)
+}
+
+// rpcValueString converts a single attribute value to a string. Binary and DN
+// values are wrapped by FreeIPA in an object.
+func rpcValueString(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case bool:
+		if v {
+			return "TRUE", true
+		}
+		return "FALSE", true
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true
+	case map[string]interface{}:
+		for _, k := range []string{"__dns_name__", "__dn__", "__base64__"} {
+			if s, ok := v[k].(string); ok {
+				return s, true
+			}
+		}
+	}
+	return "", false
+}
+
+// values returns all the values of an attribute.
+func (e rpcEntry) values(attr string) []string {
+	v, ok := e[attr]
+	if !ok || v == nil {
+		return nil
+	}
+	values, ok := v.([]interface{})
+	if !ok {
+		values = []interface{}{v}
+	}
+	var res []string
+	for _, value := range values {
+		if s, ok := rpcValueString(value); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+// value returns the first value of an attribute, or nil if it is not set.
+func (e rpcEntry) value(attr string) *string {
+	values := e.values(attr)
+	if len(values) == 0 {
+		return nil
+	}
+	return &values[0]
+}
+
+// boolValue returns the value of a boolean attribute, or nil if it is not set.
+func (e rpcEntry) boolValue(attr string) *bool {
+	s := e.value(attr)
+	if s == nil {
+		return nil
+	}
+	b := strings.EqualFold(*s, "TRUE")
+	return &b
+}
+
+// int64Value returns the value of an integer attribute, or nil if it is not set.
+func (e rpcEntry) int64Value(attr string) *int64 {
+	s := e.value(attr)
+	if s == nil {
+		return nil
+	}
+	var i int64
+	if _, err := fmt.Sscan(*s, &i); err != nil {
+		return nil
+	}
+	return &i
+}
+
+// setRPCOption adds the planned value of an attribute to the options of a
+// raw request. During updates, when state is not nil, only changed values are
+// added and cleared attributes are sent as an empty string, which removes
+// them. Unknown values are left out.
+func setRPCOption(ctx context.Context, options map[string]interface{}, name string, plan, state attr.Value) diag.Diagnostics {
+	if plan.IsUnknown() || (state != nil && plan.Equal(state)) {
+		return nil
+	}
+	if plan.IsNull() {
+		if state != nil && !state.IsNull() {
+			options[name] = ""
+		}
+		return nil
+	}
+
+	switch v := plan.(type) {
+	case types.String:
+		options[name] = v.ValueString()
+	case types.Int64:
+		options[name] = v.ValueInt64()
+	case types.Bool:
+		options[name] = v.ValueBool()
+	case types.Set:
+		elems, diags := stringSetElements(ctx, v)
+		if len(elems) == 0 {
+			if state != nil {
+				options[name] = ""
+			}
+			return diags
+		}
+		options[name] = elems
+		return diags
+	default:
+		var diags diag.Diagnostics
+		diags.AddError("Unsupported Attribute Type", fmt.Sprintf("Cannot send %s of type %T to FreeIPA. Please report this issue to the provider developers.", name, plan))
+		return diags
+	}
+	return nil
+}
@@ -7,3 +7,29 @@ func RefString(s string) *string {
 func RefBool(b bool) *bool {
 	return &b
 }
+
+func RefInt(i int) *int {
+	return &i
+}
+
+// Diff returns the elements of want missing from have, and the elements of
+// have missing from want.
+func Diff(have, want []string) (add []string, remove []string) {
+	haveSet := make(map[string]bool, len(have))
+	for _, h := range have {
+		haveSet[h] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, w := range want {
+		wantSet[w] = true
+		if !haveSet[w] {
+			add = append(add, w)
+		}
+	}
+	for _, h := range have {
+		if !wantSet[h] {
+			remove = append(remove, h)
+		}
+	}
+	return add, remove
+}